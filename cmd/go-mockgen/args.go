@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"go/parser"
 	"os"
 	"path"
 	"path/filepath"
@@ -62,6 +63,9 @@ func parseFlags() (*generation.Options, error) {
 				Interfaces:  []string{},
 			},
 		},
+		ContentOptions: generation.ContentOptions{
+			Defaults: map[string]string{},
+		},
 	}
 
 	app := kingpin.New(consts.Name, consts.Description).Version(consts.Version)
@@ -82,6 +86,32 @@ func parseFlags() (*generation.Options, error) {
 	app.Flag("for-test", "Append _test suffix to generated package names and file names.").Default("false").BoolVar(&opts.OutputOptions.ForTest)
 	app.Flag("file-prefix", "Content that is written at the top of each generated file.").StringVar(&opts.ContentOptions.FilePrefix)
 	app.Flag("build-constraints", "Build constraints that are added to each generated file.").StringVar(&opts.ContentOptions.BuildConstraints)
+	app.Flag("record-call-metadata", "Record the time, goroutine, and caller location of each invocation.").Default("false").BoolVar(&opts.ContentOptions.RecordCallMetadata)
+	app.Flag("deep-copy-arguments", "Deep copy slice, map, and pointer arguments before recording each invocation. Values held in interfaces and values of types that must not be copied (such as structs containing a sync.Mutex or a channel) are recorded as passed.").Default("false").BoolVar(&opts.ContentOptions.DeepCopyArguments)
+	app.Flag("interface-assertions", "Assert that each mock implements its source interface at compile time.").Default("false").BoolVar(&opts.ContentOptions.InterfaceAssertions)
+	app.Flag("declaration-order", "Emit the methods of each mock in the order they are declared in the source interface instead of alphabetically.").Default("false").BoolVar(&opts.PackageOptions[0].DeclarationOrder)
+	app.Flag("parameter-names", "Name mock method parameters and call struct fields after the parameters of the source interface.").Default("false").BoolVar(&opts.ContentOptions.ParameterNames)
+	app.Flag("track-concurrency", "Track the number of concurrent invocations of each mock function.").Default("false").BoolVar(&opts.ContentOptions.TrackConcurrency)
+	app.Flag("recursive-mocks", "Return nested mocks from methods returning interfaces mocked in the same output.").Default("false").BoolVar(&opts.ContentOptions.RecursiveMocks)
+	app.Flag("default", "A default value returned by noop hooks for results of a type, written as type=value.").StringMapVar(&opts.ContentOptions.Defaults)
+	app.Flag("empty-collections", "Return non-nil empty slices and maps from noop hooks.").Default("false").BoolVar(&opts.ContentOptions.EmptyCollections)
+	app.Flag("expectations", "Generate Expect on each mock function and AssertExpectations on each mock.").Default("false").BoolVar(&opts.ContentOptions.Expectations)
+	app.Flag("subscriptions", "Generate WaitForCalls and Calls on each mock function, which wait for invocations and stream them over a channel.").Default("false").BoolVar(&opts.ContentOptions.Subscriptions)
+	app.Flag("recording", "Generate constructors that record invocations of a real implementation to a fixture file and replay them.").Default("false").BoolVar(&opts.ContentOptions.Recording)
+	app.Flag("fault-injection", "Generate InjectLatency, InjectError, and SetFaultSeed on each mock function.").Default("false").BoolVar(&opts.ContentOptions.FaultInjection)
+	app.Flag("conditions", "Generate When on each mock function, which stubs invocations with matching arguments.").Default("false").BoolVar(&opts.ContentOptions.Conditions)
+	app.Flag("test-constructors", "Generate constructors bound to a testing.TB value, which report unexpected invocations to the test instead of panicking.").Default("false").BoolVar(&opts.ContentOptions.TestConstructors)
+	app.Flag("pending-hooks", "Generate PendingHooks on each mock function and AssertAllHooksConsumed on each mock.").Default("false").BoolVar(&opts.ContentOptions.PendingHooks)
+	app.Flag("call-sequence", "Record the process-wide position of each invocation, returned by the Sequence method of call structs.").Default("false").BoolVar(&opts.ContentOptions.CallSequence)
+	app.Flag("reset-methods", "Generate Reset, ClearHistory, and ClearHooks methods, which return mocks to the state in which they were constructed.").Default("false").BoolVar(&opts.ContentOptions.ResetMethods)
+	app.Flag("error-helpers", "Generate SetDefaultError, PushError, and PushErrorN on each mock function of a method whose final result is an error.").Default("false").BoolVar(&opts.ContentOptions.ErrorHelpers)
+	app.Flag("return-sequences", "Generate PushHookN, PushReturnN, and SetReturnSequence on each mock function.").Default("false").BoolVar(&opts.ContentOptions.ReturnSequences)
+	app.Flag("call-info-hooks", "Generate SetDefaultHookWithCall and PushHookWithCall on each mock function, whose hooks receive a description of the invocation.").Default("false").BoolVar(&opts.ContentOptions.CallInfoHooks)
+	app.Flag("record-panics", "Record invocations whose hook panics in the history of the mock function, along with the panic value.").Default("false").BoolVar(&opts.ContentOptions.RecordPanics)
+	app.Flag("random-constructors", "Generate NewRandomMockX constructors, whose mocks return arbitrary values that are deterministic for a given seed.").Default("false").BoolVar(&opts.ContentOptions.RandomConstructors)
+	app.Flag("blocking-hooks", "Generate PushBlockingHook on each mock function, whose hooks block the invocation until released by the test.").Default("false").BoolVar(&opts.ContentOptions.BlockingHooks)
+	app.Flag("zero-value-mocks", "Generate mocks that are usable without a constructor, along with a Get<Method>Func accessor for each method.").Default("false").BoolVar(&opts.ContentOptions.ZeroValueMocks)
+	app.Flag("with-constructors", "Generate NewMockXWith constructors, which take a struct of default hooks and functional options.").Default("false").BoolVar(&opts.ContentOptions.WithConstructors)
 
 	if _, err := app.Parse(os.Args[1:]); err != nil {
 		return nil, err
@@ -114,6 +144,14 @@ func parseManifest() ([]*generation.Options, error) {
 		if opts.FilePrefix == "" {
 			opts.FilePrefix = payload.FilePrefix
 		}
+		for typeExpr, valueExpr := range payload.Defaults {
+			if _, ok := opts.Defaults[typeExpr]; !ok {
+				if opts.Defaults == nil {
+					opts.Defaults = map[string]string{}
+				}
+				opts.Defaults[typeExpr] = valueExpr
+			}
+		}
 
 		// Overwrite
 		if payload.Force {
@@ -125,6 +163,81 @@ func parseManifest() ([]*generation.Options, error) {
 		if payload.ForTest {
 			opts.ForTest = true
 		}
+		if payload.RecordCallMetadata {
+			opts.RecordCallMetadata = true
+		}
+		if payload.DeepCopyArguments {
+			opts.DeepCopyArguments = true
+		}
+		if payload.InterfaceAssertions {
+			opts.InterfaceAssertions = true
+		}
+		if payload.DeclarationOrder {
+			opts.DeclarationOrder = true
+		}
+		if payload.ParameterNames {
+			opts.ParameterNames = true
+		}
+		if payload.TrackConcurrency {
+			opts.TrackConcurrency = true
+		}
+		if payload.RecursiveMocks {
+			opts.RecursiveMocks = true
+		}
+		if payload.EmptyCollections {
+			opts.EmptyCollections = true
+		}
+		if payload.Expectations {
+			opts.Expectations = true
+		}
+		if payload.Subscriptions {
+			opts.Subscriptions = true
+		}
+		if payload.Recording {
+			opts.Recording = true
+		}
+		if payload.FaultInjection {
+			opts.FaultInjection = true
+		}
+		if payload.Conditions {
+			opts.Conditions = true
+		}
+		if payload.TestConstructors {
+			opts.TestConstructors = true
+		}
+		if payload.PendingHooks {
+			opts.PendingHooks = true
+		}
+		if payload.CallSequence {
+			opts.CallSequence = true
+		}
+		if payload.ResetMethods {
+			opts.ResetMethods = true
+		}
+		if payload.ErrorHelpers {
+			opts.ErrorHelpers = true
+		}
+		if payload.ReturnSequences {
+			opts.ReturnSequences = true
+		}
+		if payload.CallInfoHooks {
+			opts.CallInfoHooks = true
+		}
+		if payload.RecordPanics {
+			opts.RecordPanics = true
+		}
+		if payload.RandomConstructors {
+			opts.RandomConstructors = true
+		}
+		if payload.BlockingHooks {
+			opts.BlockingHooks = true
+		}
+		if payload.ZeroValueMocks {
+			opts.ZeroValueMocks = true
+		}
+		if payload.WithConstructors {
+			opts.WithConstructors = true
+		}
 
 		// Canonicalization
 		paths := opts.Paths
@@ -151,18 +264,20 @@ func parseManifest() ([]*generation.Options, error) {
 				}
 
 				packageOptions = append(packageOptions, generation.PackageOptions{
-					ImportPaths: paths,
-					Interfaces:  source.Interfaces,
-					Exclude:     source.Exclude,
-					Prefix:      source.Prefix,
+					ImportPaths:      paths,
+					Interfaces:       source.Interfaces,
+					Exclude:          source.Exclude,
+					Prefix:           source.Prefix,
+					DeclarationOrder: opts.DeclarationOrder,
 				})
 			}
 		} else {
 			packageOptions = append(packageOptions, generation.PackageOptions{
-				ImportPaths: paths,
-				Interfaces:  opts.Interfaces,
-				Exclude:     opts.Exclude,
-				Prefix:      opts.Prefix,
+				ImportPaths:      paths,
+				Interfaces:       opts.Interfaces,
+				Exclude:          opts.Exclude,
+				Prefix:           opts.Prefix,
+				DeclarationOrder: opts.DeclarationOrder,
 			})
 		}
 
@@ -177,11 +292,36 @@ func parseManifest() ([]*generation.Options, error) {
 				ForTest:           opts.ForTest,
 			},
 			ContentOptions: generation.ContentOptions{
-				PkgName:           opts.Package,
-				OutputImportPath:  opts.ImportPath,
-				Prefix:            opts.Prefix,
-				ConstructorPrefix: opts.ConstructorPrefix,
-				FilePrefix:        opts.FilePrefix,
+				PkgName:             opts.Package,
+				OutputImportPath:    opts.ImportPath,
+				Prefix:              opts.Prefix,
+				ConstructorPrefix:   opts.ConstructorPrefix,
+				FilePrefix:          opts.FilePrefix,
+				RecordCallMetadata:  opts.RecordCallMetadata,
+				DeepCopyArguments:   opts.DeepCopyArguments,
+				InterfaceAssertions: opts.InterfaceAssertions,
+				ParameterNames:      opts.ParameterNames,
+				TrackConcurrency:    opts.TrackConcurrency,
+				RecursiveMocks:      opts.RecursiveMocks,
+				Defaults:            opts.Defaults,
+				EmptyCollections:    opts.EmptyCollections,
+				Expectations:        opts.Expectations,
+				Subscriptions:       opts.Subscriptions,
+				Recording:           opts.Recording,
+				FaultInjection:      opts.FaultInjection,
+				Conditions:          opts.Conditions,
+				TestConstructors:    opts.TestConstructors,
+				PendingHooks:        opts.PendingHooks,
+				CallSequence:        opts.CallSequence,
+				ResetMethods:        opts.ResetMethods,
+				ErrorHelpers:        opts.ErrorHelpers,
+				ReturnSequences:     opts.ReturnSequences,
+				CallInfoHooks:       opts.CallInfoHooks,
+				RecordPanics:        opts.RecordPanics,
+				RandomConstructors:  opts.RandomConstructors,
+				BlockingHooks:       opts.BlockingHooks,
+				ZeroValueMocks:      opts.ZeroValueMocks,
+				WithConstructors:    opts.WithConstructors,
 			},
 		})
 	}
@@ -194,35 +334,87 @@ type yamlPayload struct {
 	IncludeConfigPaths []string `yaml:"include-config-paths"`
 
 	// Global options
-	Exclude           []string `yaml:"exclude"`
-	Prefix            string   `yaml:"prefix"`
-	ConstructorPrefix string   `yaml:"constructor-prefix"`
-	Force             bool     `yaml:"force"`
-	DisableFormatting bool     `yaml:"disable-formatting"`
-	Goimports         string   `yaml:"goimports"`
-	ForTest           bool     `yaml:"for-test"`
-	FilePrefix        string   `yaml:"file-prefix"`
+	Exclude             []string          `yaml:"exclude"`
+	Prefix              string            `yaml:"prefix"`
+	ConstructorPrefix   string            `yaml:"constructor-prefix"`
+	Force               bool              `yaml:"force"`
+	DisableFormatting   bool              `yaml:"disable-formatting"`
+	Goimports           string            `yaml:"goimports"`
+	ForTest             bool              `yaml:"for-test"`
+	FilePrefix          string            `yaml:"file-prefix"`
+	RecordCallMetadata  bool              `yaml:"record-call-metadata"`
+	DeepCopyArguments   bool              `yaml:"deep-copy-arguments"`
+	InterfaceAssertions bool              `yaml:"interface-assertions"`
+	DeclarationOrder    bool              `yaml:"declaration-order"`
+	ParameterNames      bool              `yaml:"parameter-names"`
+	TrackConcurrency    bool              `yaml:"track-concurrency"`
+	RecursiveMocks      bool              `yaml:"recursive-mocks"`
+	Defaults            map[string]string `yaml:"defaults"`
+	EmptyCollections    bool              `yaml:"empty-collections"`
+	Expectations        bool              `yaml:"expectations"`
+	Subscriptions       bool              `yaml:"subscriptions"`
+	Recording           bool              `yaml:"recording"`
+	FaultInjection      bool              `yaml:"fault-injection"`
+	Conditions          bool              `yaml:"conditions"`
+	TestConstructors    bool              `yaml:"test-constructors"`
+	PendingHooks        bool              `yaml:"pending-hooks"`
+	CallSequence        bool              `yaml:"call-sequence"`
+	ResetMethods        bool              `yaml:"reset-methods"`
+	ErrorHelpers        bool              `yaml:"error-helpers"`
+	ReturnSequences     bool              `yaml:"return-sequences"`
+	CallInfoHooks       bool              `yaml:"call-info-hooks"`
+	RecordPanics        bool              `yaml:"record-panics"`
+	RandomConstructors  bool              `yaml:"random-constructors"`
+	BlockingHooks       bool              `yaml:"blocking-hooks"`
+	ZeroValueMocks      bool              `yaml:"zero-value-mocks"`
+	WithConstructors    bool              `yaml:"with-constructors"`
 
 	Mocks []yamlMock `yaml:"mocks"`
 }
 
 type yamlMock struct {
-	Path              string       `yaml:"path"`
-	Paths             []string     `yaml:"paths"`
-	Sources           []yamlSource `yaml:"sources"`
-	Package           string       `yaml:"package"`
-	Interfaces        []string     `yaml:"interfaces"`
-	Exclude           []string     `yaml:"exclude"`
-	Dirname           string       `yaml:"dirname"`
-	Filename          string       `yaml:"filename"`
-	ImportPath        string       `yaml:"import-path"`
-	Prefix            string       `yaml:"prefix"`
-	ConstructorPrefix string       `yaml:"constructor-prefix"`
-	Force             bool         `yaml:"force"`
-	DisableFormatting bool         `yaml:"disable-formatting"`
-	Goimports         string       `yaml:"goimports"`
-	ForTest           bool         `yaml:"for-test"`
-	FilePrefix        string       `yaml:"file-prefix"`
+	Path                string            `yaml:"path"`
+	Paths               []string          `yaml:"paths"`
+	Sources             []yamlSource      `yaml:"sources"`
+	Package             string            `yaml:"package"`
+	Interfaces          []string          `yaml:"interfaces"`
+	Exclude             []string          `yaml:"exclude"`
+	Dirname             string            `yaml:"dirname"`
+	Filename            string            `yaml:"filename"`
+	ImportPath          string            `yaml:"import-path"`
+	Prefix              string            `yaml:"prefix"`
+	ConstructorPrefix   string            `yaml:"constructor-prefix"`
+	Force               bool              `yaml:"force"`
+	DisableFormatting   bool              `yaml:"disable-formatting"`
+	Goimports           string            `yaml:"goimports"`
+	ForTest             bool              `yaml:"for-test"`
+	FilePrefix          string            `yaml:"file-prefix"`
+	RecordCallMetadata  bool              `yaml:"record-call-metadata"`
+	DeepCopyArguments   bool              `yaml:"deep-copy-arguments"`
+	InterfaceAssertions bool              `yaml:"interface-assertions"`
+	DeclarationOrder    bool              `yaml:"declaration-order"`
+	ParameterNames      bool              `yaml:"parameter-names"`
+	TrackConcurrency    bool              `yaml:"track-concurrency"`
+	RecursiveMocks      bool              `yaml:"recursive-mocks"`
+	Defaults            map[string]string `yaml:"defaults"`
+	EmptyCollections    bool              `yaml:"empty-collections"`
+	Expectations        bool              `yaml:"expectations"`
+	Subscriptions       bool              `yaml:"subscriptions"`
+	Recording           bool              `yaml:"recording"`
+	FaultInjection      bool              `yaml:"fault-injection"`
+	Conditions          bool              `yaml:"conditions"`
+	TestConstructors    bool              `yaml:"test-constructors"`
+	PendingHooks        bool              `yaml:"pending-hooks"`
+	CallSequence        bool              `yaml:"call-sequence"`
+	ResetMethods        bool              `yaml:"reset-methods"`
+	ErrorHelpers        bool              `yaml:"error-helpers"`
+	ReturnSequences     bool              `yaml:"return-sequences"`
+	CallInfoHooks       bool              `yaml:"call-info-hooks"`
+	RecordPanics        bool              `yaml:"record-panics"`
+	RandomConstructors  bool              `yaml:"random-constructors"`
+	BlockingHooks       bool              `yaml:"blocking-hooks"`
+	ZeroValueMocks      bool              `yaml:"zero-value-mocks"`
+	WithConstructors    bool              `yaml:"with-constructors"`
 }
 
 type yamlSource struct {
@@ -341,6 +533,16 @@ func validateOptions(opts *generation.Options) (bool, error) {
 		return false, fmt.Errorf("constructor-`prefix `%s` is illegal", opts.ContentOptions.ConstructorPrefix)
 	}
 
+	for typeExpr, valueExpr := range opts.ContentOptions.Defaults {
+		if _, err := parser.ParseExpr(typeExpr); err != nil {
+			return false, fmt.Errorf("default type `%s` is illegal", typeExpr)
+		}
+
+		if _, err := parser.ParseExpr(valueExpr); err != nil {
+			return false, fmt.Errorf("default value `%s` for type `%s` is illegal", valueExpr, typeExpr)
+		}
+	}
+
 	return false, nil
 }
 
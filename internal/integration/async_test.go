@@ -0,0 +1,64 @@
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForCalls(t *testing.T) {
+	mock := mocks.NewMockClient()
+
+	go func() {
+		mock.Do("foo")
+		mock.Do("bar")
+	}()
+
+	assert.Nil(t, mock.DoFunc.WaitForCalls(context.Background(), 2))
+	assert.Len(t, mock.DoFunc.History(), 2)
+
+	// Already satisfied
+	assert.Nil(t, mock.DoFunc.WaitForCalls(context.Background(), 1))
+}
+
+func TestWaitForCallsCanceled(t *testing.T) {
+	mock := mocks.NewMockClient()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	assert.Equal(t, context.DeadlineExceeded, mock.DoFunc.WaitForCalls(ctx, 1))
+}
+
+func TestCallsSubscription(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.Do("before")
+
+	calls := mock.DoFunc.Calls(context.Background())
+	go func() {
+		mock.Do("foo")
+		mock.Do("bar")
+	}()
+
+	assert.Equal(t, "foo", (<-calls).Arg0)
+	assert.Equal(t, "bar", (<-calls).Arg0)
+}
+
+func TestCallsSubscriptionCancel(t *testing.T) {
+	mock := mocks.NewMockClient()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := mock.DoFunc.Calls(ctx)
+	mock.Do("foo")
+	cancel()
+
+	// The channel is closed once the subscription is canceled
+	for range calls {
+	}
+
+	mock.Do("bar")
+	assert.Len(t, mock.DoFunc.History(), 2)
+}
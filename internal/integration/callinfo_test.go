@@ -0,0 +1,148 @@
+package integration
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHookWithCall(t *testing.T) {
+	errThird := errors.New("third")
+
+	mock := mocks.NewMockClient()
+	mock.DoFunc.SetDefaultHookWithCall(func(info mocks.ClientCallInfo, command string) (interface{}, error) {
+		assert.Same(t, mock, info.Mock)
+		assert.Equal(t, "Do", info.Method)
+
+		if info.Index == 2 {
+			return nil, errThird
+		}
+		return info.Index, nil
+	})
+	mock.DoFunc.PushHook(func(command string) (interface{}, error) { return "pushed", nil })
+	mock.DoFunc.PushHookWithCall(func(info mocks.ClientCallInfo, command string) (interface{}, error) {
+		return info.Index * 10, nil
+	})
+
+	var results []interface{}
+	for i := 0; i < 5; i++ {
+		r, err := mock.Do("foo")
+		if err != nil {
+			results = append(results, err)
+		} else {
+			results = append(results, r)
+		}
+	}
+	assert.Equal(t, []interface{}{"pushed", 10, errThird, 3, 4}, results)
+
+	mock.DoFunc.SetDefaultReturn("plain", nil)
+	r, _ := mock.Do("foo")
+	assert.Equal(t, "plain", r)
+
+	mock.DoFunc.ClearHistory()
+	mock.DoFunc.PushHookWithCall(func(info mocks.ClientCallInfo, command string) (interface{}, error) {
+		return info.Index, nil
+	})
+	r, _ = mock.Do("foo")
+	assert.Equal(t, 0, r)
+}
+
+func TestHookWithCallConcurrent(t *testing.T) {
+	var mutex sync.Mutex
+	indexes := map[int]struct{}{}
+
+	mock := mocks.NewMockClient()
+	mock.DoFunc.SetDefaultHookWithCall(func(info mocks.ClientCallInfo, command string) (interface{}, error) {
+		mutex.Lock()
+		indexes[info.Index] = struct{}{}
+		mutex.Unlock()
+		return nil, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mock.Do("foo")
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, indexes, 50)
+}
+
+func TestHookWithCallIndexMatchesHistory(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.DoFunc.SetDefaultHookWithCall(func(info mocks.ClientCallInfo, command string) (interface{}, error) {
+		return info.Index, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			mock.Do("foo")
+		}()
+	}
+	wg.Wait()
+
+	history := mock.DoFunc.History()
+	require.Len(t, history, 50)
+	for i, call := range history {
+		assert.Equal(t, i, call.Result0)
+	}
+}
+
+func TestHookWithCallClearHistoryInFlight(t *testing.T) {
+	entered := make(chan struct{})
+	release := make(chan struct{})
+
+	mock := mocks.NewMockClient()
+	mock.DoFunc.SetDefaultHookWithCall(func(info mocks.ClientCallInfo, command string) (interface{}, error) {
+		return info.Index, nil
+	})
+	mock.DoFunc.PushHookWithCall(func(info mocks.ClientCallInfo, command string) (interface{}, error) {
+		close(entered)
+		<-release
+		return info.Index, nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		mock.Do("foo")
+	}()
+
+	<-entered
+	require.Len(t, mock.DoFunc.History(), 1)
+	assert.Equal(t, "foo", mock.DoFunc.History()[0].Arg0)
+	assert.Nil(t, mock.DoFunc.History()[0].Result0)
+
+	// The invocation in flight is discarded along with the rest of the history
+	mock.DoFunc.ClearHistory()
+	close(release)
+	<-done
+	assert.Empty(t, mock.DoFunc.History())
+
+	r, _ := mock.Do("bar")
+	assert.Equal(t, 0, r)
+	require.Len(t, mock.DoFunc.History(), 1)
+	assert.Equal(t, "bar", mock.DoFunc.History()[0].Arg0)
+}
+
+func TestHookWithCallReset(t *testing.T) {
+	mock := mocks.NewMockClientFrom(testClient{})
+	mock.DoFunc.SetDefaultHookWithCall(func(info mocks.ClientCallInfo, command string) (interface{}, error) {
+		return "hooked", nil
+	})
+	mock.Reset()
+
+	r, _ := mock.Do("foo")
+	assert.Equal(t, "foo!", r)
+}
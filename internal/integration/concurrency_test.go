@@ -0,0 +1,79 @@
+package integration
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/concurrencymocks"
+	mockassert "github.com/derision-test/go-mockgen/v2/testutil/assert"
+	. "github.com/derision-test/go-mockgen/v2/testutil/gomega"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrackConcurrency(t *testing.T) {
+	mock := concurrencymocks.NewMockClient()
+
+	var wg sync.WaitGroup
+	var gates []*concurrencymocks.ClientDoFuncGate
+	for i := 0; i < 3; i++ {
+		gates = append(gates, mock.DoFunc.PushBlockingHook())
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = mock.Do("foo")
+		}()
+	}
+
+	// Every invocation is paused inside its hook
+	for _, gate := range gates {
+		<-gate.Entered()
+	}
+	assert.Equal(t, 3, mock.DoFunc.InFlight())
+
+	for _, gate := range gates {
+		gate.Release("bar", nil)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 0, mock.DoFunc.InFlight())
+	assert.Equal(t, 3, mock.DoFunc.MaxConcurrency())
+	mockassert.MaxConcurrentCalls(t, mock.DoFunc, 3)
+	mockassert.MinConcurrentCalls(t, mock.DoFunc, 3)
+
+	// Serial invocations do not raise the maximum
+	mock.DoFunc.ClearHistory()
+	_, _ = mock.Do("foo")
+	_, _ = mock.Do("foo")
+	assert.Equal(t, 1, mock.DoFunc.MaxConcurrency())
+}
+
+func TestTrackConcurrencyFailure(t *testing.T) {
+	mock := concurrencymocks.NewMockClient()
+	_, _ = mock.Do("foo")
+
+	testingT := &recordingT{TB: t}
+	assert.False(t, mockassert.MinConcurrentCalls(testingT, mock.DoFunc, 2))
+	assert.Len(t, testingT.errors, 1)
+	assert.Contains(t, testingT.errors[0], "to be called at least 2 times concurrently, called 1 times concurrently")
+}
+
+func TestTrackConcurrencyPanic(t *testing.T) {
+	mock := concurrencymocks.NewMockClient()
+	mock.CloseFunc.SetDefaultHook(func() error { panic("boom") })
+
+	assert.Panics(t, func() { _ = mock.Close() })
+	assert.Equal(t, 0, mock.CloseFunc.InFlight())
+	assert.Equal(t, 1, mock.CloseFunc.MaxConcurrency())
+}
+
+func TestGomegaConcurrency(t *testing.T) {
+	RegisterTestingT(t)
+
+	mock := concurrencymocks.NewMockClient()
+	_, _ = mock.Do("foo")
+
+	Expect(mock.DoFunc).To(HaveAtMostConcurrentCalls(1))
+	Expect(mock.DoFunc).NotTo(HaveAtLeastConcurrentCalls(2))
+}
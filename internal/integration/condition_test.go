@@ -0,0 +1,74 @@
+package integration
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConditions(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.DoFunc.SetDefaultReturn("default", nil)
+	mock.DoFunc.PushReturn("pushed", nil)
+	mock.DoFunc.When("foo").Return("foo", nil)
+	mock.DoFunc.WhenFunc(func(v string) bool { return strings.HasPrefix(v, "b") }).Return(nil, fmt.Errorf("uh-oh"))
+
+	// Conditions are checked before the hook queue
+	r, _ := mock.Do("foo")
+	assert.Equal(t, "foo", r)
+	_, err := mock.Do("bar")
+	assert.EqualError(t, err, "uh-oh")
+
+	// Unmatched invocations fall through to the hook queue and then the default hook
+	r, _ = mock.Do("qux")
+	assert.Equal(t, "pushed", r)
+	r, _ = mock.Do("qux")
+	assert.Equal(t, "default", r)
+
+	// Conditions are not consumed
+	r, _ = mock.Do("foo")
+	assert.Equal(t, "foo", r)
+}
+
+func TestConditionsVariadic(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.DoArgsFunc.When("baz", 1, 2).Return("equal", nil)
+	mock.DoArgsFunc.WhenFunc(func(command string, args ...interface{}) bool {
+		return len(args) == 2 && args[0] == 1 && args[1] == 2
+	}).Return("matched", nil)
+	mock.DoArgsFunc.WhenFunc(func(command string, args ...interface{}) bool { return command == "foo" }).Hook(func(command string, args ...interface{}) (interface{}, error) {
+		return len(args), nil
+	})
+
+	r, _ := mock.DoArgs("baz", 1, 2)
+	assert.Equal(t, "equal", r)
+	r, _ = mock.DoArgs("bar", 1, 2)
+	assert.Equal(t, "matched", r)
+	r, _ = mock.DoArgs("foo", 1, 2, 3)
+	assert.Equal(t, 3, r)
+	r, _ = mock.DoArgs("baz", 1)
+	assert.Nil(t, r)
+}
+
+func TestConditionsReentrantMatcher(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.DoFunc.SetDefaultReturn("default", nil)
+
+	// Matchers are evaluated without holding the lock of the mock function. The
+	// invocation being matched is already part of the history, as these mocks are
+	// generated with the call-info-hooks flag.
+	isKnown := func(v string) bool { return len(mock.DoFunc.History()) > 1 && v == "foo" }
+	mock.DoFunc.WhenFunc(isKnown).Return("known", nil)
+	mock.DoArgsFunc.ExpectFunc(func(v string, args ...interface{}) bool { return len(mock.DoArgsFunc.History()) == 1 }).Return("expected", nil)
+
+	r, _ := mock.Do("foo")
+	assert.Equal(t, "default", r)
+	r, _ = mock.Do("foo")
+	assert.Equal(t, "known", r)
+	r, _ = mock.DoArgs("foo")
+	assert.Equal(t, "expected", r)
+	assert.True(t, mock.AssertExpectations(t))
+}
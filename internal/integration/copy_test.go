@@ -0,0 +1,51 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/copymocks"
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	mockassert "github.com/derision-test/go-mockgen/v2/testutil/assert"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeepCopyArguments(t *testing.T) {
+	mock := copymocks.NewMockClient()
+
+	values := []int{1, 2, 3}
+	args := []interface{}{"foo", values}
+	mock.DoArgs("foo", args...)
+
+	// Mutate the arguments after the call
+	values[0] = 4
+	args[0] = "replaced"
+
+	// The variadic slice is owned by the argument and is copied, while the values held in
+	// its interface elements are recorded as passed
+	recorded := mock.DoArgsFunc.History()[0].Arg1
+	assert.Equal(t, []interface{}{"foo", []int{4, 2, 3}}, recorded)
+}
+
+func TestDeepCopyArgumentsPreservesIdentity(t *testing.T) {
+	mock := copymocks.NewMockClient()
+
+	ctx := context.Background()
+	value := &struct{ Values []int }{Values: []int{1}}
+	mock.DoArgs("foo", ctx, value)
+
+	recorded := mock.DoArgsFunc.History()[0].Arg1
+	assert.True(t, ctx == recorded[0])
+	assert.True(t, value == recorded[1])
+	assert.True(t, mockassert.CalledWith(t, mock.DoArgsFunc, mockassert.Values("foo", ctx, value)))
+}
+
+func TestArgumentsNotCopiedByDefault(t *testing.T) {
+	mock := mocks.NewMockClient()
+
+	args := []interface{}{1}
+	mock.DoArgs("foo", args...)
+	args[0] = 2
+
+	assert.Equal(t, []interface{}{2}, mock.DoArgsFunc.History()[0].Arg1)
+}
@@ -0,0 +1,64 @@
+package integration
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/defaultmocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultValues(t *testing.T) {
+	catalog := defaultmocks.NewMockCatalog()
+
+	names := catalog.Names()
+	assert.NotNil(t, names)
+	assert.Empty(t, names)
+
+	counts := catalog.Counts()
+	assert.NotNil(t, counts)
+	assert.Empty(t, counts)
+
+	updated, err := catalog.Updated()
+	assert.Equal(t, time.Unix(0, 0).UTC(), updated)
+	assert.EqualError(t, err, "unstubbed")
+
+	// Hooks take precedence over default values
+	catalog.NamesFunc.SetDefaultReturn(nil)
+	assert.Nil(t, catalog.Names())
+
+	// Reset restores the default values
+	catalog.Reset()
+	assert.NotNil(t, catalog.Names())
+}
+
+func TestDefaultValuesConstructors(t *testing.T) {
+	for _, parent := range []*defaultmocks.MockParent{
+		defaultmocks.NewMockParentT(t),
+		defaultmocks.NewMockParentWith(defaultmocks.MockParentFuncs{}),
+	} {
+		children := parent.GetChildren()
+		assert.NotNil(t, children)
+		assert.Empty(t, children)
+
+		child, err := parent.GetChild(0)
+		assert.Nil(t, child)
+		assert.EqualError(t, err, "unstubbed")
+	}
+}
+
+func TestDefaultValuesZeroValue(t *testing.T) {
+	var catalog defaultmocks.MockCatalog
+
+	names := catalog.Names()
+	assert.NotNil(t, names)
+	assert.Empty(t, names)
+
+	counts := catalog.Counts()
+	assert.NotNil(t, counts)
+	assert.Empty(t, counts)
+
+	updated, err := catalog.Updated()
+	assert.Equal(t, time.Unix(0, 0).UTC(), updated)
+	assert.EqualError(t, err, "unstubbed")
+}
@@ -0,0 +1,33 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestErrorHelpers(t *testing.T) {
+	errDefault := errors.New("default")
+	errPushed := errors.New("pushed")
+
+	mock := mocks.NewMockClient()
+	mock.DoFunc.SetDefaultError(errDefault)
+	mock.DoFunc.PushError(errPushed)
+	mock.DoFunc.PushErrorN(2, errPushed)
+	mock.CloseFunc.PushError(errPushed)
+
+	for i := 0; i < 3; i++ {
+		r, err := mock.Do("foo")
+		assert.Nil(t, r)
+		assert.Equal(t, errPushed, err)
+	}
+
+	r, err := mock.Do("foo")
+	assert.Nil(t, r)
+	assert.Equal(t, errDefault, err)
+
+	assert.Equal(t, errPushed, mock.Close())
+	assert.Nil(t, mock.Close())
+}
@@ -0,0 +1,62 @@
+package integration
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpectations(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.DoFunc.SetDefaultReturn("default", nil)
+	mock.DoFunc.Expect("foo").Times(2).Return("foo", nil)
+	mock.DoFunc.Expect("bar")
+
+	r, _ := mock.Do("foo")
+	assert.Equal(t, "foo", r)
+	r, _ = mock.Do("foo")
+	assert.Equal(t, "foo", r)
+
+	// Expectations without a hook fall through to the default hook
+	r, _ = mock.Do("bar")
+	assert.Equal(t, "default", r)
+
+	// Methods without expectations are never unexpected
+	mock.Close()
+
+	assert.True(t, mock.AssertExpectations(t))
+}
+
+func TestExpectationsFailures(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.DoFunc.Expect("foo").Return("foo", nil)
+	mock.DoFunc.Expect("bar").Times(2)
+	mock.DoFunc.ExpectFunc(func(v string) bool { return v == "quux" })
+	mock.DoArgsFunc.Expect("baz", 1)
+
+	mock.Do("foo")
+	mock.Do("foo")
+	mock.Do("bar")
+	mock.Do("qux")
+	mock.DoArgs("baz", 1)
+
+	testingT := &errorCollector{}
+	assert.False(t, mock.AssertExpectations(testingT))
+	assert.Equal(t, []string{
+		"MockClient expectations were not met:\n" +
+			`  - over-satisfied expectation: expected MockClient.Do("foo") to be called 1 times, called 2 times` + "\n" +
+			`  - unmet expectation: expected MockClient.Do("bar") to be called 2 times, called 1 times` + "\n" +
+			`  - unmet expectation: expected MockClient.Do(<func(string) bool>) to be called 1 times, called 0 times` + "\n" +
+			`  - unexpected call: MockClient.Do("qux")`,
+	}, testingT.errors)
+}
+
+type errorCollector struct {
+	errors []string
+}
+
+func (c *errorCollector) Errorf(format string, args ...interface{}) {
+	c.errors = append(c.errors, fmt.Sprintf(format, args...))
+}
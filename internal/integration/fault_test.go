@@ -0,0 +1,106 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	mockassert "github.com/derision-test/go-mockgen/v2/testutil/assert"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInjectError(t *testing.T) {
+	errInjected := errors.New("injected")
+
+	run := func(seed int64) []bool {
+		mock := mocks.NewMockClientFrom(testClient{})
+		mock.DoFunc.SetFaultSeed(seed)
+		mock.DoFunc.InjectError(0.3, errInjected)
+
+		var injected []bool
+		for i := 0; i < 100; i++ {
+			r, err := mock.Do("foo")
+			if err != nil {
+				assert.Equal(t, errInjected, err)
+				assert.Nil(t, r)
+			} else {
+				assert.Equal(t, "foo!", r)
+			}
+			injected = append(injected, err != nil)
+		}
+
+		// Injected failures are distinguishable in the history
+		mockassert.CalledN(t, mock.DoFunc, 100)
+		for i, call := range mock.DoFunc.History() {
+			assert.Equal(t, injected[i], call.Fault().Err != nil)
+			assert.Equal(t, call.Fault().Err, call.Result1)
+		}
+
+		return injected
+	}
+
+	injected := run(1)
+	assert.Equal(t, injected, run(1))
+	assert.Contains(t, injected, true)
+	assert.Contains(t, injected, false)
+}
+
+func TestInjectErrorBypassesHooks(t *testing.T) {
+	errInjected := errors.New("injected")
+
+	mock := mocks.NewMockClient()
+	mock.DoFunc.PushReturn("pushed", nil)
+	mock.DoFunc.InjectError(1, errInjected)
+
+	_, err := mock.Do("foo")
+	assert.Equal(t, errInjected, err)
+
+	// Queued hooks are not consumed by injected failures
+	mock.DoFunc.InjectError(0, nil)
+	r, err := mock.Do("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "pushed", r)
+}
+
+func TestInjectErrorCountsExpectations(t *testing.T) {
+	errInjected := errors.New("injected")
+
+	mock := mocks.NewMockClient()
+	mock.DoFunc.Expect("foo").Times(2).Return("expected", nil)
+	mock.DoFunc.InjectError(1, errInjected)
+
+	_, err := mock.Do("foo")
+	assert.Equal(t, errInjected, err)
+
+	// Invocations answered by an injected error still count towards expectations
+	mock.DoFunc.InjectError(0, nil)
+	r, err := mock.Do("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "expected", r)
+	assert.True(t, mock.AssertExpectations(t))
+}
+
+func TestSetFaultSeedWithoutErrorResult(t *testing.T) {
+	mock := mocks.NewMockCatalog()
+	mock.CountsFunc.SetFaultSeed(1)
+	mock.CountsFunc.InjectLatency(time.Millisecond)
+
+	mock.Counts()
+	assert.Equal(t, time.Millisecond, mock.CountsFunc.History()[0].Fault().Latency)
+}
+
+func TestInjectLatency(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.CloseFunc.InjectLatency(20 * time.Millisecond)
+
+	start := time.Now()
+	assert.NoError(t, mock.Close())
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+	assert.Equal(t, 20*time.Millisecond, mock.CloseFunc.History()[0].Fault().Latency)
+
+	// Reset disables fault injection
+	mock.Reset()
+	assert.NoError(t, mock.Close())
+	assert.Zero(t, mock.CloseFunc.History()[0].Fault().Latency)
+}
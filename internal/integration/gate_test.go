@@ -0,0 +1,70 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushBlockingHook(t *testing.T) {
+	mock := mocks.NewMockClient()
+	gate := mock.DoFunc.PushBlockingHook()
+
+	type result struct {
+		value interface{}
+		err   error
+	}
+	results := make(chan result, 1)
+	go func() {
+		value, err := mock.Do("foo")
+		results <- result{value, err}
+	}()
+
+	// The invocation is paused inside the hook until released
+	<-gate.Entered()
+	select {
+	case <-results:
+		t.Fatal("invocation returned before gate was released")
+	default:
+	}
+
+	gate.Release("released", nil)
+	assert.Equal(t, result{"released", nil}, <-results)
+	assert.Len(t, mock.DoFunc.History(), 1)
+
+	assert.Panics(t, func() { gate.Release("again", nil) })
+}
+
+func TestPushBlockingHookFail(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	mock := mocks.NewMockClient()
+	gate := mock.CloseFunc.PushBlockingHook()
+
+	// Gates released before the invocation begins do not block
+	gate.Fail(errFailed)
+	assert.Equal(t, errFailed, mock.Close())
+
+	select {
+	case <-gate.Entered():
+	default:
+		t.Fatal("expected gate to be entered")
+	}
+}
+
+func TestPushBlockingHookWithoutResults(t *testing.T) {
+	mock := mocks.NewMockI1[string]()
+	gate := mock.M1Func.PushBlockingHook()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		mock.M1("foo")
+	}()
+
+	<-gate.Entered()
+	gate.Release()
+	<-done
+}
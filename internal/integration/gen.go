@@ -1,4 +1,11 @@
 package integration
 
-//go:generate go run ../../cmd/go-mockgen ./testdata -f -d ./testdata --disable-formatting
-//go:generate go run ../../cmd/go-mockgen ./testdata -f -d ./testdata/mocks --disable-formatting
+//go:generate go run ../../cmd/go-mockgen ./testdata -f -d ./testdata --interface-assertions --disable-formatting
+//go:generate go run ../../cmd/go-mockgen ./testdata -f -d ./testdata/mocks --interface-assertions --test-constructors --pending-hooks --call-sequence --reset-methods --error-helpers --return-sequences --call-info-hooks --record-panics --random-constructors --blocking-hooks --zero-value-mocks --with-constructors --conditions --expectations --fault-injection --recording --subscriptions --disable-formatting
+//go:generate go run ../../cmd/go-mockgen ./testdata -f -d ./testdata/metadatamocks -i Client --record-call-metadata --disable-formatting
+//go:generate go run ../../cmd/go-mockgen ./testdata -f -d ./testdata/copymocks -i Client --deep-copy-arguments --disable-formatting
+//go:generate go run ../../cmd/go-mockgen ./testdata -f -d ./testdata/ordermocks -i Ordered --declaration-order --disable-formatting
+//go:generate go run ../../cmd/go-mockgen ./testdata -f -d ./testdata/namedmocks --parameter-names --record-call-metadata --deep-copy-arguments --interface-assertions --disable-formatting
+//go:generate go run ../../cmd/go-mockgen ./testdata -f -d ./testdata/concurrencymocks -i Client --track-concurrency --reset-methods --blocking-hooks --disable-formatting
+//go:generate go run ../../cmd/go-mockgen ./testdata -f -d ./testdata/recursivemocks -i Parent -i Child -i Builder --recursive-mocks --reset-methods --zero-value-mocks --with-constructors --disable-formatting
+//go:generate go run ../../cmd/go-mockgen ./testdata -f -d ./testdata/defaultmocks -i Catalog -i Parent --test-constructors --reset-methods --zero-value-mocks --with-constructors --empty-collections --default "time.Time=time.Unix(0, 0).UTC()" --default "error=errors.New(\"unstubbed\")" --disable-formatting
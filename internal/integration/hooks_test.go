@@ -0,0 +1,54 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	mockassert "github.com/derision-test/go-mockgen/v2/testutil/assert"
+	. "github.com/derision-test/go-mockgen/v2/testutil/gomega"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPendingHooks(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.DoFunc.PushReturn("foo", nil)
+	mock.DoFunc.PushReturn("bar", nil)
+	mock.CloseFunc.PushReturn(nil)
+	assert.Equal(t, 2, mock.DoFunc.PendingHooks())
+
+	mock.Do("baz")
+	assert.Equal(t, 1, mock.DoFunc.PendingHooks())
+
+	testingT := &errorCollector{}
+	assert.False(t, mock.AssertAllHooksConsumed(testingT))
+	assert.Equal(t, []string{
+		"MockClient hooks were not consumed:\n" +
+			"  - MockClient.Close has 1 unconsumed hooks\n" +
+			"  - MockClient.Do has 1 unconsumed hooks",
+	}, testingT.errors)
+
+	mock.Do("baz")
+	mock.Close()
+	assert.True(t, mock.AssertAllHooksConsumed(t))
+	mockassert.HooksConsumed(t, mock.DoFunc)
+}
+
+func TestPendingHooksTestConstructor(t *testing.T) {
+	testingT := &recordingT{TB: t}
+	mock := mocks.NewMockClientT(testingT)
+	mock.DoFunc.PushReturn("foo", nil)
+
+	testingT.runCleanups()
+	assert.Equal(t, []string{"MockClient hooks were not consumed:\n  - MockClient.Do has 1 unconsumed hooks"}, testingT.errors)
+}
+
+func TestGomegaPendingHooks(t *testing.T) {
+	RegisterTestingT(t)
+
+	mock := mocks.NewMockClient()
+	mock.CloseFunc.PushReturn(nil)
+	Expect(mock.CloseFunc).NotTo(HaveConsumedAllHooks())
+	Expect(mock.Close()).To(BeNil())
+	Expect(mock.CloseFunc).To(HaveConsumedAllHooks())
+}
@@ -0,0 +1,44 @@
+package integration
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/metadatamocks"
+	mockassert "github.com/derision-test/go-mockgen/v2/testutil/assert"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallMetadata(t *testing.T) {
+	mock := metadatamocks.NewMockClient()
+
+	before := time.Now()
+	mock.Do("foo") // metadata_test.go:17
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		mock.Do("bar")
+	}()
+	<-done
+
+	history := mock.DoFunc.History()
+	assert.False(t, history[0].Timestamp().Before(before))
+	assert.True(t, strings.HasSuffix(history[0].Caller(), "metadata_test.go:17"), history[0].Caller())
+	assert.NotZero(t, history[0].GoroutineID())
+	assert.NotZero(t, history[1].GoroutineID())
+	assert.NotEqual(t, history[0].GoroutineID(), history[1].GoroutineID())
+}
+
+func TestCallMetadataFailureMessage(t *testing.T) {
+	mock := metadatamocks.NewMockClient()
+	mock.Do("foo")
+
+	testingT := &errorCollector{}
+	assert.False(t, mockassert.NotCalled(testingT, mock.DoFunc))
+	assert.Len(t, testingT.errors, 1)
+	assert.Contains(t, testingT.errors[0], "Recorded invocations:")
+	assert.Contains(t, testingT.errors[0], "[foo] at ")
+	assert.Contains(t, testingT.errors[0], "metadata_test.go:36 on goroutine ")
+}
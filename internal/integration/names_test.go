@@ -0,0 +1,29 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/namedmocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParameterNames(t *testing.T) {
+	mock := namedmocks.NewMockClient()
+	mock.DoArgsFunc.SetDefaultHook(func(command string, args ...interface{}) (interface{}, error) {
+		return len(args), nil
+	})
+
+	mock.Do("foo")
+	r, _ := mock.DoArgs("bar", 1, 2)
+	assert.Equal(t, 2, r)
+
+	assert.Equal(t, "foo", mock.DoFunc.History()[0].Command)
+	assert.Equal(t, "bar", mock.DoArgsFunc.History()[0].Command)
+	assert.Equal(t, []interface{}{1, 2}, mock.DoArgsFunc.History()[0].Arg1)
+	assert.Equal(t, []interface{}{"bar", 1, 2}, mock.DoArgsFunc.History()[0].Args())
+
+	retrier := namedmocks.NewMockRetrier()
+	retrier.Retry(context.Background(), nil)
+	assert.Equal(t, context.Background(), retrier.RetryFunc.History()[0].Ctx)
+}
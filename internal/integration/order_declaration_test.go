@@ -0,0 +1,43 @@
+package integration
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/ordermocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeclarationOrder(t *testing.T) {
+	assert.Equal(t, []string{
+		"AddChildFunc",
+		"AlphaFunc",
+		"CloseFunc",
+		"GetChildFunc",
+		"GetChildrenFunc",
+		"MiddleFunc",
+		"ZetaFunc",
+	}, fieldNames(reflect.TypeOf(mocks.MockOrdered{})))
+
+	assert.Equal(t, []string{
+		"ZetaFunc",
+		"CloseFunc",
+		"AlphaFunc",
+		"AddChildFunc",
+		"GetChildrenFunc",
+		"GetChildFunc",
+		"MiddleFunc",
+	}, fieldNames(reflect.TypeOf(ordermocks.MockOrdered{})))
+}
+
+func fieldNames(t reflect.Type) []string {
+	names := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).IsExported() {
+			names = append(names, t.Field(i).Name)
+		}
+	}
+
+	return names
+}
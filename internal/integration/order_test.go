@@ -0,0 +1,84 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	mockassert "github.com/derision-test/go-mockgen/v2/testutil/assert"
+	. "github.com/derision-test/go-mockgen/v2/testutil/gomega"
+	mockrequire "github.com/derision-test/go-mockgen/v2/testutil/require"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallSequence(t *testing.T) {
+	mock1 := mocks.NewMockClient()
+	mock2 := mocks.NewMockClient()
+
+	mock1.Do("foo")
+	mock2.Close()
+	mock1.Do("bar")
+
+	assert.Less(t, mock1.DoFunc.History()[0].Sequence(), mock2.CloseFunc.History()[0].Sequence())
+	assert.Less(t, mock2.CloseFunc.History()[0].Sequence(), mock1.DoFunc.History()[1].Sequence())
+}
+
+func TestInOrder(t *testing.T) {
+	mock1 := mocks.NewMockClient()
+	mock2 := mocks.NewMockClient()
+
+	mock1.Do("foo")
+	mock2.Close()
+	mock1.DoArgs("bar")
+
+	assert.True(t, mockassert.InOrder(t, []interface{}{mock1.DoFunc, mock2.CloseFunc, mock1.DoArgsFunc}))
+	assert.False(t, mockassert.InOrder(&errorCollector{}, []interface{}{mock2.CloseFunc, mock1.DoFunc}))
+	assert.False(t, mockassert.InOrder(&errorCollector{}, []interface{}{mock1.DoFunc, mock1.CloseFunc}))
+
+	// Individual calls can be compared when a method is invoked on either side of another
+	mock1.Do("baz")
+	assert.False(t, mockassert.InOrder(&errorCollector{}, []interface{}{mock1.DoFunc, mock2.CloseFunc}))
+	assert.True(t, mockassert.InOrder(t, []interface{}{mock1.DoFunc.History()[0], mock2.CloseFunc, mock1.DoFunc.History()[1]}))
+}
+
+func TestInOrderMessage(t *testing.T) {
+	mock1 := mocks.NewMockClient()
+	mock2 := mocks.NewMockClient()
+
+	mock2.Close()
+	mock1.Do("foo")
+
+	collector := &errorCollector{}
+	assert.False(t, mockassert.InOrder(collector, []interface{}{mock1.DoFunc, mock2.CloseFunc}, "checking %s", "order"))
+	require.Len(t, collector.errors, 1)
+	assert.Contains(t, collector.errors[0], "checking order")
+
+	mockrequire.InOrder(t, []interface{}{mock2.CloseFunc, mock1.DoFunc}, "checking %s", "order")
+}
+
+func TestGomegaCalledBefore(t *testing.T) {
+	RegisterTestingT(t)
+
+	mock1 := mocks.NewMockClient()
+	mock2 := mocks.NewMockClient()
+
+	mock1.Do("foo")
+	mock2.Close()
+	Expect(mock1.DoFunc).To(BeCalledBefore(mock2.CloseFunc))
+	Expect(mock2.CloseFunc).NotTo(BeCalledBefore(mock1.DoFunc))
+}
+
+func TestInOrderNestedCalls(t *testing.T) {
+	mock1 := mocks.NewMockClient()
+	mock2 := mocks.NewMockClient()
+
+	// The invocation of Do begins first but returns after the invocation of Close
+	mock1.DoFunc.SetDefaultHook(func(string) (interface{}, error) {
+		return nil, mock2.Close()
+	})
+	mock1.Do("foo")
+
+	assert.Less(t, mock1.DoFunc.History()[0].Sequence(), mock2.CloseFunc.History()[0].Sequence())
+	assert.True(t, mockassert.InOrder(t, []interface{}{mock1.DoFunc, mock2.CloseFunc}))
+}
@@ -0,0 +1,37 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	mockassert "github.com/derision-test/go-mockgen/v2/testutil/assert"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPanickingHookIsRecorded(t *testing.T) {
+	errPanic := errors.New("panic")
+
+	mock := mocks.NewMockClient()
+	mock.DoFunc.PushHook(func(command string) (interface{}, error) { panic(errPanic) })
+
+	assert.PanicsWithValue(t, errPanic, func() { _, _ = mock.Do("foo") })
+	_, _ = mock.Do("bar")
+
+	mockassert.CalledN(t, mock.DoFunc, 2)
+	mockassert.CalledWith(t, mock.DoFunc, mockassert.Values("foo"))
+
+	history := mock.DoFunc.History()
+	assert.Equal(t, errPanic, history[0].PanicValue())
+	assert.Nil(t, history[0].Result0)
+	assert.Nil(t, history[1].PanicValue())
+}
+
+func TestStrictConstructorPanicIsRecorded(t *testing.T) {
+	mock := mocks.NewStrictMockClient()
+
+	assert.Panics(t, func() { _, _ = mock.Do("foo") })
+
+	mockassert.CalledOnceWith(t, mock.DoFunc, mockassert.Values("foo"))
+	assert.NotNil(t, mock.DoFunc.History()[0].PanicValue())
+}
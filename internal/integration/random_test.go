@@ -0,0 +1,41 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+type randomResult struct {
+	Name   string
+	Counts map[string]int
+	Next   *randomResult
+}
+
+func TestRandomConstructor(t *testing.T) {
+	generate := func(seed int64) []randomResult {
+		mock := mocks.NewRandomMockI2[int, []randomResult](seed)
+		mock.M1(0)
+		return mock.M2(0)
+	}
+
+	// Results are reproducible for a given seed
+	for seed := int64(0); seed < 10; seed++ {
+		assert.Equal(t, generate(seed), generate(seed))
+	}
+	assert.NotEqual(t, generate(1), generate(2))
+
+	// Interface results are left nil
+	mock := mocks.NewRandomMockClient(1)
+	for i := 0; i < 10; i++ {
+		r, err := mock.Do("foo")
+		assert.Nil(t, r)
+		assert.Nil(t, err)
+	}
+
+	// Random mocks can still be overwritten
+	mock.DoFunc.PushReturn("pushed", nil)
+	r, _ := mock.Do("foo")
+	assert.Equal(t, "pushed", r)
+}
@@ -0,0 +1,85 @@
+package integration
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata"
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/derision-test/go-mockgen/v2/testutil/mocksupport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "client.json")
+
+	recorder := mocksupport.NewRecorder(path)
+	recording := mocks.NewRecordingMockClient(testClient{}, recorder)
+	recording.CloseFunc.SetDefaultReturn(errors.New("closed"))
+
+	r, err := recording.Do("foo")
+	require.NoError(t, err)
+	assert.Equal(t, "foo!", r)
+	_, _ = recording.DoArgs("bar", 1, "baz")
+	assert.EqualError(t, recording.Close(), "closed")
+	require.NoError(t, recorder.Save())
+
+	testingT := &recordingT{TB: t}
+	mock, err := mocks.NewMockClientFromRecording(testingT, path)
+	require.NoError(t, err)
+
+	r, err = mock.Do("foo")
+	assert.NoError(t, err)
+	assert.Equal(t, "foo!", r)
+
+	r, err = mock.DoArgs("bar", 1, "baz")
+	assert.NoError(t, err)
+	assert.Equal(t, "bar", r)
+
+	// Close was overwritten while recording, so it was never delegated or recorded
+	assert.NoError(t, mock.Close())
+	r, err = mock.Do("qux")
+	assert.NoError(t, err)
+	assert.Empty(t, r)
+	assert.Equal(t, []string{
+		`unexpected invocation of MockClient.Close()`,
+		`unexpected invocation of MockClient.Do("qux")`,
+	}, testingT.errors)
+
+	// Replayed mocks can still be overwritten
+	mock.DoFunc.PushReturn("pushed", nil)
+	r, _ = mock.Do("qux")
+	assert.Equal(t, "pushed", r)
+}
+
+func TestReplayWithDifferentContext(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "retrier.json")
+
+	recorder := mocksupport.NewRecorder(path)
+	recording := mocks.NewRecordingMockRetrier(testRetrier{}, recorder)
+	assert.EqualError(t, recording.Retry(context.Background(), nil), "retries exhausted")
+	require.NoError(t, recorder.Save())
+
+	mock, err := mocks.NewMockRetrierFromRecording(t, path)
+	require.NoError(t, err)
+
+	// The context and the command are not part of the match
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	assert.EqualError(t, mock.Retry(ctx, func() error { return nil }), "retries exhausted")
+}
+
+func TestReplayMissingRecording(t *testing.T) {
+	_, err := mocks.NewMockClientFromRecording(t, filepath.Join(t.TempDir(), "missing.json"))
+	assert.Error(t, err)
+}
+
+type testRetrier struct{}
+
+func (testRetrier) Retry(ctx context.Context, command testdata.Command) error {
+	return errors.New("retries exhausted")
+}
@@ -0,0 +1,75 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/recursivemocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecursiveMocks(t *testing.T) {
+	parent := recursivemocks.NewMockParent()
+
+	child, err := parent.GetChild(0)
+	require.Nil(t, err)
+	require.NotNil(t, child)
+	assert.Same(t, parent.GetChildFunc.Result0Mock(), child)
+
+	// Nested mocks are reused across invocations and can be chained
+	again, _ := parent.GetChild(1)
+	assert.Same(t, child, again)
+	assert.NotNil(t, child.Parent())
+
+	// Slices of interfaces are not nested
+	assert.Nil(t, parent.GetChildren())
+}
+
+func TestRecursiveMocksConfigureNested(t *testing.T) {
+	parent := recursivemocks.NewMockParent()
+	other := recursivemocks.NewMockParent()
+	parent.GetChildFunc.Result0Mock().ParentFunc.SetDefaultReturn(other)
+
+	child, _ := parent.GetChild(0)
+	assert.Same(t, other, child.Parent())
+	assert.Len(t, parent.GetChildFunc.Result0Mock().ParentFunc.History(), 1)
+}
+
+func TestRecursiveMocksHooksTakePrecedence(t *testing.T) {
+	parent := recursivemocks.NewMockParent()
+	parent.GetChildFunc.PushReturn(nil, errors.New("oops"))
+
+	child, err := parent.GetChild(0)
+	assert.Nil(t, child)
+	assert.EqualError(t, err, "oops")
+
+	child, err = parent.GetChild(0)
+	assert.NotNil(t, child)
+	assert.Nil(t, err)
+}
+
+func TestRecursiveMocksSelf(t *testing.T) {
+	builder := recursivemocks.NewMockBuilder()
+	assert.Same(t, builder, builder.WithChild(nil).WithChild(nil))
+	assert.Len(t, builder.WithChildFunc.History(), 2)
+
+	parent, err := builder.Build()
+	assert.Nil(t, err)
+	assert.Same(t, builder.BuildFunc.Result0Mock(), parent)
+}
+
+func TestRecursiveMocksReset(t *testing.T) {
+	parent := recursivemocks.NewMockParent()
+	child, _ := parent.GetChild(0)
+
+	parent.Reset()
+	assert.NotSame(t, child, parent.GetChildFunc.Result0Mock())
+}
+
+func TestRecursiveMocksZeroValue(t *testing.T) {
+	var parent recursivemocks.MockParent
+
+	child, _ := parent.GetChild(0)
+	assert.NotNil(t, child)
+}
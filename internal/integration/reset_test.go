@@ -0,0 +1,79 @@
+package integration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClearHistoryAndHooks(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.DoFunc.SetDefaultReturn("default", nil)
+	mock.DoFunc.PushReturn("foo", nil)
+	mock.DoFunc.PushReturn("bar", nil)
+
+	r, _ := mock.Do("baz")
+	assert.Equal(t, "foo", r)
+
+	mock.DoFunc.ClearHistory()
+	assert.Empty(t, mock.DoFunc.History())
+
+	mock.DoFunc.ClearHooks()
+	assert.Equal(t, 0, mock.DoFunc.PendingHooks())
+
+	// Default hook is unaffected
+	r, _ = mock.Do("baz")
+	assert.Equal(t, "default", r)
+	assert.Len(t, mock.DoFunc.History(), 1)
+}
+
+func TestReset(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.DoFunc.SetDefaultReturn("default", nil)
+	mock.DoFunc.PushReturn("foo", nil)
+	mock.DoFunc.When("bar").Return("bar", nil)
+	mock.DoFunc.Expect("baz")
+	mock.Do("baz")
+	mock.Close()
+
+	mock.Reset()
+	assert.Empty(t, mock.DoFunc.History())
+	assert.Empty(t, mock.CloseFunc.History())
+	assert.Equal(t, 0, mock.DoFunc.PendingHooks())
+	assert.True(t, mock.AssertExpectations(t))
+
+	// The constructor's noop default hook is restored
+	r, _ := mock.Do("bar")
+	assert.Nil(t, r)
+}
+
+func TestResetRestoresDelegatingHook(t *testing.T) {
+	mock := mocks.NewMockClientFrom(testClient{})
+	mock.DoFunc.SetDefaultReturn("override", nil)
+
+	r, _ := mock.Do("foo")
+	assert.Equal(t, "override", r)
+
+	mock.Reset()
+	r, _ = mock.Do("foo")
+	assert.Equal(t, "foo!", r)
+}
+
+func TestResetRestoresStrictHook(t *testing.T) {
+	mock := mocks.NewStrictMockRetrier()
+	mock.RetryFunc.SetDefaultReturn(nil)
+	assert.NotPanics(t, func() { _ = mock.Retry(context.Background(), func() error { return nil }) })
+
+	mock.Reset()
+	assert.Panics(t, func() { _ = mock.Retry(context.Background(), func() error { return nil }) })
+}
+
+type testClient struct{}
+
+func (testClient) Close() error                           { return nil }
+func (testClient) Do(command string) (interface{}, error) { return command + "!", nil }
+func (testClient) DoArgs(command string, args ...interface{}) (interface{}, error) {
+	return command, nil
+}
@@ -0,0 +1,58 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPushN(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.DoFunc.SetDefaultReturn("default", nil)
+	mock.DoFunc.PushReturnN(2, "pushed", nil)
+	mock.DoFunc.PushHookN(2, func(command string) (interface{}, error) { return command, nil })
+
+	var results []interface{}
+	for i := 0; i < 5; i++ {
+		r, _ := mock.Do("hook")
+		results = append(results, r)
+	}
+	assert.Equal(t, []interface{}{"pushed", "pushed", "hook", "hook", "default"}, results)
+}
+
+func TestSetReturnSequence(t *testing.T) {
+	errFailed := errors.New("failed")
+
+	for _, testCase := range []struct {
+		name       string
+		repeatLast bool
+		expected   []interface{}
+	}{
+		{name: "repeat last", repeatLast: true, expected: []interface{}{1, 2, errFailed, errFailed}},
+		{name: "fall through", repeatLast: false, expected: []interface{}{1, 2, errFailed, "default"}},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			mock := mocks.NewMockClient()
+			mock.DoFunc.SetDefaultReturn("default", nil)
+			mock.DoFunc.PushReturn("discarded", nil)
+			mock.DoFunc.SetReturnSequence(testCase.repeatLast,
+				mocks.ClientDoResults{Result0: 1},
+				mocks.ClientDoResults{Result0: 2},
+				mocks.ClientDoResults{Result1: errFailed},
+			)
+
+			var results []interface{}
+			for i := 0; i < 4; i++ {
+				r, err := mock.Do("foo")
+				if err != nil {
+					results = append(results, err)
+				} else {
+					results = append(results, r)
+				}
+			}
+			assert.Equal(t, testCase.expected, results)
+		})
+	}
+}
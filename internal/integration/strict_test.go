@@ -2,6 +2,8 @@ package integration
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
@@ -27,3 +29,50 @@ func TestStrictConstructor(t *testing.T) {
 		})
 	})
 }
+
+func TestStrictTestConstructor(t *testing.T) {
+	testingT := &recordingT{TB: t}
+	mock := mocks.NewStrictMockClientT(testingT)
+	mock.DoFunc.Expect("foo").Return("foo", nil)
+
+	// Unexpected invocations fail the test instead of panicking, even from another goroutine
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = mock.DoArgs("bar", 1, 2)
+	}()
+	<-done
+
+	assert.Equal(t, []string{`unexpected invocation of MockClient.DoArgs("bar", []interface {}{1, 2})`}, testingT.errors)
+
+	// Expectations are asserted on cleanup
+	testingT.errors = nil
+	testingT.runCleanups()
+	assert.Len(t, testingT.errors, 1)
+	assert.Contains(t, testingT.errors[0], `unmet expectation: expected MockClient.Do("foo") to be called 1 times, called 0 times`)
+}
+
+type recordingT struct {
+	testing.TB
+	mutex    sync.Mutex
+	errors   []string
+	cleanups []func()
+}
+
+func (t *recordingT) Helper() {}
+
+func (t *recordingT) Errorf(format string, args ...interface{}) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func (t *recordingT) Cleanup(f func()) {
+	t.cleanups = append(t.cleanups, f)
+}
+
+func (t *recordingT) runCleanups() {
+	for _, f := range t.cleanups {
+		f()
+	}
+}
@@ -0,0 +1,9 @@
+package testdata
+
+import "time"
+
+type Catalog interface {
+	Names() []string
+	Counts() map[string]int
+	Updated() (time.Time, error)
+}
@@ -0,0 +1,11 @@
+package testdata
+
+import "io"
+
+type Ordered interface {
+	Zeta()
+	io.Closer
+	Alpha(v int) int
+	Parent
+	Middle()
+}
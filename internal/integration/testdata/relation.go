@@ -9,3 +9,8 @@ type Parent interface {
 type Child interface {
 	Parent() Parent
 }
+
+type Builder interface {
+	WithChild(c Child) Builder
+	Build() (Parent, error)
+}
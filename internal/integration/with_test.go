@@ -0,0 +1,58 @@
+package integration
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewMockWith(t *testing.T) {
+	errClosed := errors.New("closed")
+
+	mock := mocks.NewMockClientWith(mocks.MockClientFuncs{
+		Close: func() error { return errClosed },
+		Do:    func(command string) (interface{}, error) { return command + "!", nil },
+	})
+
+	value, err := mock.Do("foo")
+	assert.Equal(t, "foo!", value)
+	assert.Nil(t, err)
+	assert.Equal(t, errClosed, mock.Close())
+
+	// Methods without a hook return zero values
+	value, err = mock.DoArgs("foo")
+	assert.Nil(t, value)
+	assert.Nil(t, err)
+
+	// Reset restores the given hooks
+	mock.DoFunc.SetDefaultReturn("bar", nil)
+	mock.Reset()
+	value, _ = mock.Do("foo")
+	assert.Equal(t, "foo!", value)
+}
+
+func TestNewMockWithOptions(t *testing.T) {
+	mock := mocks.NewMockClientWith(
+		mocks.MockClientFuncs{
+			Do: func(command string) (interface{}, error) { return command + "!", nil },
+		},
+		mocks.WithClientDo(func(command string) (interface{}, error) { return command + "?", nil }),
+		mocks.WithClientDoArgs(func(command string, args ...interface{}) (interface{}, error) { return len(args), nil }),
+	)
+
+	// Options are applied after the given funcs
+	value, _ := mock.Do("foo")
+	assert.Equal(t, "foo?", value)
+	value, _ = mock.DoArgs("foo", 1, 2)
+	assert.Equal(t, 2, value)
+	assert.Nil(t, mock.Close())
+}
+
+func TestNewMockWithGeneric(t *testing.T) {
+	mock := mocks.NewMockI2With(mocks.MockI2Funcs[string, int]{}, mocks.WithI2M2(func(v string) int { return len(v) }))
+	assert.Equal(t, 3, mock.M2("foo"))
+	mock.M1("foo")
+	assert.Len(t, mock.M1Func.History(), 1)
+}
@@ -0,0 +1,87 @@
+package integration
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/derision-test/go-mockgen/v2/internal/integration/testdata/mocks"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZeroValueMock(t *testing.T) {
+	var mock mocks.MockClient
+
+	value, err := mock.Do("foo")
+	assert.Nil(t, value)
+	assert.Nil(t, err)
+	assert.Nil(t, mock.Close())
+	assert.Len(t, mock.DoFunc.History(), 1)
+	assert.Len(t, mock.CloseFunc.History(), 1)
+
+	mock.DoFunc.PushReturn("bar", nil)
+	value, err = mock.Do("foo")
+	assert.Equal(t, "bar", value)
+	assert.Nil(t, err)
+
+	// Reset restores the zero-value behavior
+	mock.DoFunc.SetDefaultReturn("baz", nil)
+	mock.Reset()
+	value, err = mock.Do("foo")
+	assert.Nil(t, value)
+	assert.Nil(t, err)
+}
+
+func TestZeroValueMockConfiguredBeforeUse(t *testing.T) {
+	type fixture struct {
+		client mocks.MockClient
+	}
+
+	errClosed := errors.New("closed")
+
+	f := &fixture{}
+	f.client.GetCloseFunc().SetDefaultReturn(errClosed)
+	f.client.GetDoFunc().PushReturn("bar", nil)
+	assert.Equal(t, errClosed, f.client.Close())
+
+	value, err := f.client.Do("foo")
+	assert.Equal(t, "bar", value)
+	assert.Nil(t, err)
+	assert.True(t, f.client.AssertAllHooksConsumed(t))
+}
+
+func TestZeroValueMockAccessor(t *testing.T) {
+	mock := mocks.NewMockClient()
+	assert.Same(t, mock.DoFunc, mock.GetDoFunc())
+
+	// Accessors do not replace mock function objects that are already set
+	doFunc := &mocks.ClientDoFunc{}
+	mock.DoFunc = doFunc
+	assert.Same(t, doFunc, mock.GetDoFunc())
+}
+
+func TestZeroValueMockConcurrentInitialization(t *testing.T) {
+	mock := new(mocks.MockClient)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, _ = mock.Do("foo")
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, mock.DoFunc.History(), 10)
+}
+
+func TestZeroValueMockFunc(t *testing.T) {
+	mock := mocks.NewMockClient()
+	mock.DoFunc = &mocks.ClientDoFunc{}
+
+	value, err := mock.Do("foo")
+	assert.Nil(t, value)
+	assert.Nil(t, err)
+	assert.Len(t, mock.DoFunc.History(), 1)
+}
@@ -0,0 +1,184 @@
+package generation
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	gotypes "go/types"
+	"sort"
+
+	"github.com/dave/jennifer/jen"
+)
+
+// normalizeTypeExpression returns the given type expression in the form used to
+// match it against the result types of mocked methods. Package-qualified types
+// are written with the name of the package (e.g., `time.Time`).
+func normalizeTypeExpression(expr string) (string, error) {
+	node, err := parser.ParseExpr(expr)
+	if err != nil {
+		return "", err
+	}
+
+	buffer := &bytes.Buffer{}
+	if err := printer.Fprint(buffer, token.NewFileSet(), node); err != nil {
+		return "", err
+	}
+
+	return buffer.String(), nil
+}
+
+// normalizeDefaults returns a copy of the given map from type expressions to
+// default value expressions keyed by normalized type expressions. Keys that do
+// not parse are dropped, as they cannot match any type.
+func normalizeDefaults(defaults map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return nil
+	}
+
+	normalized := make(map[string]string, len(defaults))
+	for typeExpr, valueExpr := range defaults {
+		if key, err := normalizeTypeExpression(typeExpr); err == nil {
+			normalized[key] = valueExpr
+		}
+	}
+
+	return normalized
+}
+
+// defaultValue returns the value returned as the i-th result of the given method
+// by the noop functions of the constructors, if it is not the zero value of its type.
+func (iface *wrappedInterface) defaultValue(method *wrappedMethod, i int, outputImportPath string) (jen.Code, bool) {
+	resultType := method.Results[i]
+
+	if valueExpr, ok := iface.defaults[gotypes.TypeString(resultType, packageName)]; ok {
+		return generateValueExpression(valueExpr, resultType, outputImportPath), true
+	}
+
+	if iface.emptyCollections {
+		if _, ok := resultType.(*gotypes.TypeParam); !ok {
+			switch resultType.Underlying().(type) {
+			case *gotypes.Map, *gotypes.Slice:
+				// <type>{}
+				return compose(jen.Add(method.resultTypes[i]), jen.Values()), true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// generateDefaultValueAssignments generates an assignment of the default value of
+// each result of the given method that has one to the named result r0, r1, ...
+func generateDefaultValueAssignments(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) []jen.Code {
+	assignments := make([]jen.Code, 0, len(method.Results))
+	for i := range method.Results {
+		if value, ok := iface.defaultValue(method, i, outputImportPath); ok {
+			// r<i> = <value>
+			assignments = append(assignments, jen.Id(fmt.Sprintf("r%d", i)).Op("=").Add(value))
+		}
+	}
+
+	return assignments
+}
+
+// generateValueExpression renders the given Go expression verbatim, except that
+// references to packages are qualified so that their imports are added to the
+// generated file. A package name resolves to a package referenced by the given
+// type or, failing that, to the standard library package with the same path.
+// References to other packages and to the output package are left as written.
+func generateValueExpression(expr string, typ gotypes.Type, outputImportPath string) jen.Code {
+	fset := token.NewFileSet()
+	node, err := parser.ParseExprFrom(fset, "", expr, 0)
+	if err != nil {
+		return jen.Op(expr)
+	}
+
+	importPaths := map[string]string{}
+	collectPackages(typ, importPaths, map[gotypes.Type]struct{}{})
+
+	var selectors []*ast.SelectorExpr
+	ast.Inspect(node, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok {
+				if _, ok := importPaths[ident.Name]; !ok && isStandardPackage(ident.Name) {
+					importPaths[ident.Name] = ident.Name
+				}
+				if _, ok := importPaths[ident.Name]; ok {
+					selectors = append(selectors, selector)
+				}
+			}
+		}
+
+		return true
+	})
+	sort.Slice(selectors, func(i, j int) bool { return selectors[i].Pos() < selectors[j].Pos() })
+
+	offset := 0
+	parts := make([]jen.Code, 0, len(selectors)*2+1)
+	for _, selector := range selectors {
+		start := fset.Position(selector.Pos()).Offset
+		end := fset.Position(selector.End()).Offset
+
+		reference := jen.Qual(importPaths[selector.X.(*ast.Ident).Name], selector.Sel.Name)
+		if importPaths[selector.X.(*ast.Ident).Name] == outputImportPath {
+			reference = jen.Id(selector.Sel.Name)
+		}
+
+		parts = append(parts, jen.Op(expr[offset:start]), reference)
+		offset = end
+	}
+	parts = append(parts, jen.Op(expr[offset:]))
+
+	return jen.Custom(jen.Options{}, parts...)
+}
+
+// collectPackages adds the name and path of each package referenced by the given
+// type to the given map.
+func collectPackages(typ gotypes.Type, importPaths map[string]string, seen map[gotypes.Type]struct{}) {
+	if _, ok := seen[typ]; ok {
+		return
+	}
+	seen[typ] = struct{}{}
+
+	recur := func(typ gotypes.Type) { collectPackages(typ, importPaths, seen) }
+
+	switch t := typ.(type) {
+	case *gotypes.Alias:
+		if pkg := t.Obj().Pkg(); pkg != nil {
+			importPaths[pkg.Name()] = pkg.Path()
+		}
+	case *gotypes.Named:
+		if pkg := t.Obj().Pkg(); pkg != nil {
+			importPaths[pkg.Name()] = pkg.Path()
+		}
+		for i := 0; i < t.TypeArgs().Len(); i++ {
+			recur(t.TypeArgs().At(i))
+		}
+	case *gotypes.Array:
+		recur(t.Elem())
+	case *gotypes.Chan:
+		recur(t.Elem())
+	case *gotypes.Map:
+		recur(t.Key())
+		recur(t.Elem())
+	case *gotypes.Pointer:
+		recur(t.Elem())
+	case *gotypes.Slice:
+		recur(t.Elem())
+	}
+}
+
+// packageName qualifies types by the name of their package.
+func packageName(pkg *gotypes.Package) string {
+	return pkg.Name()
+}
+
+// isStandardPackage returns true if the given path names a standard library package.
+func isStandardPackage(path string) bool {
+	pkg, err := build.Default.Import(path, "", build.FindOnly)
+	return err == nil && pkg.Goroot
+}
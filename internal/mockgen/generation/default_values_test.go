@@ -0,0 +1,22 @@
+package generation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeDefaults(t *testing.T) {
+	defaults := normalizeDefaults(map[string]string{
+		"map[string] int": "map[string]int{}",
+		"func( ) error":   "func() error { return nil }",
+		"[]string":        "[]string{}",
+		"[]":              "nil",
+	})
+
+	assert.Equal(t, map[string]string{
+		"map[string]int": "map[string]int{}",
+		"func() error":   "func() error { return nil }",
+		"[]string":       "[]string{}",
+	}, defaults)
+}
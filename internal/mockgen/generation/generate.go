@@ -16,6 +16,10 @@ import (
 	"github.com/derision-test/go-mockgen/v2/internal/mockgen/types"
 )
 
+// supportImportPath is the import path of the package containing helpers that are
+// referenced by generated code.
+const supportImportPath = consts.PackageName + "/testutil/mocksupport"
+
 type Options struct {
 	PackageOptions []PackageOptions
 	OutputOptions  OutputOptions
@@ -23,10 +27,11 @@ type Options struct {
 }
 
 type PackageOptions struct {
-	ImportPaths []string
-	Interfaces  []string
-	Exclude     []string
-	Prefix      string
+	ImportPaths      []string
+	Interfaces       []string
+	Exclude          []string
+	Prefix           string
+	DeclarationOrder bool
 }
 
 type OutputOptions struct {
@@ -39,15 +44,49 @@ type OutputOptions struct {
 }
 
 type ContentOptions struct {
-	PkgName           string
-	OutputImportPath  string
-	Prefix            string
-	ConstructorPrefix string
-	FilePrefix        string
-	BuildConstraints  string
+	PkgName             string
+	OutputImportPath    string
+	Prefix              string
+	ConstructorPrefix   string
+	FilePrefix          string
+	BuildConstraints    string
+	RecordCallMetadata  bool
+	DeepCopyArguments   bool
+	InterfaceAssertions bool
+	ParameterNames      bool
+	TrackConcurrency    bool
+	RecursiveMocks      bool
+	Defaults            map[string]string
+	EmptyCollections    bool
+	Conditions          bool
+	TestConstructors    bool
+	PendingHooks        bool
+	CallSequence        bool
+	ResetMethods        bool
+	ErrorHelpers        bool
+	ReturnSequences     bool
+	CallInfoHooks       bool
+	RecordPanics        bool
+	RandomConstructors  bool
+	BlockingHooks       bool
+	ZeroValueMocks      bool
+	WithConstructors    bool
+	Expectations        bool
+	Recording           bool
+	FaultInjection      bool
+	Subscriptions       bool
+
+	// generatedMocks maps the qualified name of each interface mocked in the same
+	// output to the name of its mock struct. It is populated by Generate when
+	// RecursiveMocks is set.
+	generatedMocks map[string]string
 }
 
 func Generate(ifaces []*types.Interface, opts *Options) error {
+	if opts.ContentOptions.RecursiveMocks {
+		opts.ContentOptions.generatedMocks = generatedMockNames(ifaces, opts.ContentOptions)
+	}
+
 	if opts.OutputOptions.OutputFilename != "" {
 		return generateFile(ifaces, opts)
 	}
@@ -152,10 +191,7 @@ func generateAndRender(ifaces []*types.Interface, filename string, opts *Options
 }
 
 func generateContent(ifaces []*types.Interface, pkgName string, opts ContentOptions) (string, error) {
-	prefix := opts.Prefix
-	constructorPrefix := opts.ConstructorPrefix
 	fileContentPrefix := opts.FilePrefix
-	outputImportPath := opts.OutputImportPath
 
 	if fileContentPrefix != "" {
 		separator := "\n// "
@@ -171,7 +207,9 @@ func generateContent(ifaces []*types.Interface, pkgName string, opts ContentOpti
 
 	for _, iface := range ifaces {
 		log.Printf("generating code for interface '%s'\n", iface.Name)
-		generateInterface(file, iface, prefix, constructorPrefix, outputImportPath)
+		if err := generateInterface(file, iface, opts); err != nil {
+			return "", err
+		}
 	}
 
 	buffer := &bytes.Buffer{}
@@ -182,12 +220,39 @@ func generateContent(ifaces []*types.Interface, pkgName string, opts ContentOpti
 	return buffer.String(), nil
 }
 
-func generateInterface(file *jen.File, iface *types.Interface, prefix, constructorPrefix, outputImportPath string) {
+// generatedMockNames returns a map from the qualified name of each of the given
+// interfaces to the name of its mock struct. Generic interfaces are omitted, as
+// references to them are instantiated with arbitrary type arguments.
+func generatedMockNames(ifaces []*types.Interface, opts ContentOptions) map[string]string {
+	names := make(map[string]string, len(ifaces))
+	for _, iface := range ifaces {
+		if len(iface.TypeParams) == 0 {
+			_, _, mockStructName := mockNames(iface, opts)
+			names[iface.ImportPath+"."+iface.Name] = mockStructName
+		}
+	}
+
+	return names
+}
+
+// mockNames returns the prefix, title-cased interface name, and mock struct name
+// used in the declarations generated for the given interface.
+func mockNames(iface *types.Interface, opts ContentOptions) (prefix, titleName, mockStructName string) {
+	prefix = opts.Prefix
 	if iface.Prefix != "" {
 		// Override parent prefix if one is set on the iface
 		prefix = iface.Prefix
 	}
 
+	titleName = strings.ToUpper(string(iface.Name[0])) + iface.Name[1:]
+	mockStructName = fmt.Sprintf("Mock%s%s", prefix, titleName)
+	return prefix, titleName, mockStructName
+}
+
+func generateInterface(file *jen.File, iface *types.Interface, opts ContentOptions) error {
+	constructorPrefix := opts.ConstructorPrefix
+	outputImportPath := opts.OutputImportPath
+
 	withConstructorPrefix := func(f func(*wrappedInterface, string, string) jen.Code) func(*wrappedInterface, string) jen.Code {
 		return func(iface *wrappedInterface, outputImportPath string) jen.Code {
 			return f(iface, constructorPrefix, outputImportPath)
@@ -196,29 +261,136 @@ func generateInterface(file *jen.File, iface *types.Interface, prefix, construct
 
 	topLevelGenerators := []func(*wrappedInterface, string) jen.Code{
 		generateMockStruct,
+		generateMockCallInfoStruct,
+		withConstructorPrefix(generateMockFuncsStruct),
+		withConstructorPrefix(generateMockOptionType),
 		withConstructorPrefix(generateMockStructConstructor),
+		withConstructorPrefix(generateMockStructWithConstructor),
+		withConstructorPrefix(generateMockOptionFunctions),
 		withConstructorPrefix(generateMockStructStrictConstructor),
 		withConstructorPrefix(generateMockStructFromConstructor),
+		withConstructorPrefix(generateMockStructRandomConstructor),
+		withConstructorPrefix(generateMockStructTestConstructor),
+		withConstructorPrefix(generateMockStructStrictTestConstructor),
+		withConstructorPrefix(generateMockStructFromTestConstructor),
+		withConstructorPrefix(generateMockStructRecordingConstructor),
+		withConstructorPrefix(generateMockStructFromRecordingConstructor),
+		generateMockInterfaceAssertion,
+		generateMockAssertExpectationsMethod,
+		generateMockAssertAllHooksConsumedMethod,
+		generateMockResetMethod,
+		generateMockInitFuncsMethod,
 	}
 
 	methodGenerators := []func(*wrappedInterface, *wrappedMethod, string) jen.Code{
 		generateMockFuncStruct,
 		generateMockInterfaceMethod,
+		generateMockFuncAccessorMethod,
 		generateMockFuncSetHookMethod,
 		generateMockFuncPushHookMethod,
+		generateMockFuncPushHookNMethod,
+		generateMockFuncSetHookWithCallMethod,
+		generateMockFuncPushHookWithCallMethod,
+		generateMockFuncPushBlockingHookMethod,
 		generateMockFuncSetReturnMethod,
 		generateMockFuncPushReturnMethod,
+		generateMockFuncPushReturnNMethod,
+		generateMockFuncSetReturnSequenceMethod,
+		generateMockFuncSetErrorMethod,
+		generateMockFuncPushErrorMethod,
+		generateMockFuncPushErrorNMethod,
+		generateMockFuncInjectErrorMethod,
+		generateMockFuncInjectLatencyMethod,
+		generateMockFuncSetFaultSeedMethod,
+		generateMockFuncWhenMethod,
+		generateMockFuncWhenFuncMethod,
+		generateMockFuncExpectMethod,
+		generateMockFuncExpectFuncMethod,
+		generateMockFuncExpectHelperMethod,
 		generateMockFuncNextHookMethod,
+		generateMockFuncReserveCallMethod,
+		generateMockFuncBindCallHookMethod,
+		generateMockFuncClaimExpectationMethod,
 		generateMockFuncAppendCallMethod,
+		generateMockFuncRecoverCallMethod,
 		generateMockFuncHistoryMethod,
+		generateMockFuncWaitForCallsMethod,
+		generateMockFuncCallsMethod,
+		generateMockFuncPendingHooksMethod,
+		generateMockFuncBeginCallMethod,
+		generateMockFuncEndCallMethod,
+		generateMockFuncInFlightMethod,
+		generateMockFuncMaxConcurrencyMethod,
+		generateMockFuncResultMockMethods,
+		generateMockFuncClearHistoryMethod,
+		generateMockFuncClearHooksMethod,
+		generateMockFuncResetMethod,
+		generateMockFuncExpectationFailuresMethod,
 		generateMockFuncCallStruct,
+		generateMockFuncResultsStruct,
 		generateMockFuncCallArgsMethod,
 		generateMockFuncCallResultsMethod,
+		generateMockFuncCallSequenceMethod,
+		generateMockFuncCallPanicValueMethod,
+		generateMockFuncCallFaultMethod,
+		generateMockFuncCallTimestampMethod,
+		generateMockFuncCallGoroutineIDMethod,
+		generateMockFuncCallCallerMethod,
+		generateMockFuncConditionStruct,
+		generateMockFuncConditionHookMethod,
+		generateMockFuncConditionReturnMethod,
+		generateMockFuncGateStruct,
+		generateMockFuncGateEnteredMethod,
+		generateMockFuncGateReleaseMethod,
+		generateMockFuncGateFailMethod,
+		generateMockFuncExpectationStruct,
+		generateMockFuncExpectationTimesMethod,
+		generateMockFuncExpectationHookMethod,
+		generateMockFuncExpectationReturnMethod,
 	}
 
-	titleName := strings.ToUpper(string(iface.Name[0])) + iface.Name[1:]
-	mockStructName := fmt.Sprintf("Mock%s%s", prefix, titleName)
+	prefix, titleName, mockStructName := mockNames(iface, opts)
 	wrappedInterface := wrapInterface(iface, prefix, titleName, mockStructName, outputImportPath)
+	wrappedInterface.recordCallMetadata = opts.RecordCallMetadata
+	wrappedInterface.deepCopyArguments = opts.DeepCopyArguments
+	wrappedInterface.interfaceAssertions = opts.InterfaceAssertions
+	wrappedInterface.trackConcurrency = opts.TrackConcurrency
+	wrappedInterface.constructorPrefix = constructorPrefix
+	wrappedInterface.generatedMocks = opts.generatedMocks
+	wrappedInterface.defaults = normalizeDefaults(opts.Defaults)
+	wrappedInterface.emptyCollections = opts.EmptyCollections
+	wrappedInterface.conditions = opts.Conditions
+	wrappedInterface.testConstructors = opts.TestConstructors
+	wrappedInterface.pendingHooks = opts.PendingHooks
+	wrappedInterface.callSequence = opts.CallSequence
+	wrappedInterface.resetMethods = opts.ResetMethods
+	wrappedInterface.errorHelpers = opts.ErrorHelpers
+	wrappedInterface.returnSequences = opts.ReturnSequences
+	wrappedInterface.callInfoHooks = opts.CallInfoHooks
+	wrappedInterface.recordPanics = opts.RecordPanics
+	wrappedInterface.randomConstructors = opts.RandomConstructors
+	wrappedInterface.blockingHooks = opts.BlockingHooks
+	wrappedInterface.zeroValueMocks = opts.ZeroValueMocks
+	wrappedInterface.withConstructors = opts.WithConstructors
+	wrappedInterface.expectations = opts.Expectations
+	wrappedInterface.recording = opts.Recording
+	wrappedInterface.subscriptions = opts.Subscriptions
+	wrappedInterface.faultInjection = opts.FaultInjection
+
+	if err := wrappedInterface.checkReservedNames(); err != nil {
+		return err
+	}
+
+	if opts.ParameterNames {
+		typeParamNames := make([]string, 0, len(iface.TypeParams))
+		for _, typeParam := range iface.TypeParams {
+			typeParamNames = append(typeParamNames, typeParam.Name)
+		}
+
+		for _, method := range wrappedInterface.wrappedMethods {
+			method.useParameterNames(typeParamNames)
+		}
+	}
 
 	for _, generator := range topLevelGenerators {
 		file.Add(generator(wrappedInterface, outputImportPath))
@@ -231,4 +403,6 @@ func generateInterface(file *jen.File, iface *types.Interface, prefix, construct
 			file.Line()
 		}
 	}
+
+	return nil
 }
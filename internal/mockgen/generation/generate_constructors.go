@@ -11,7 +11,7 @@ import (
 
 func generateMockStructConstructor(iface *wrappedInterface, constructorPrefix, outputImportPath string) jen.Code {
 	makeField := func(method *wrappedMethod) jen.Code {
-		return makeDefaultHookField(iface, method, outputImportPath, generateNoopFunction(iface, method, outputImportPath))
+		return makeNoopHookField(iface, method, outputImportPath)
 	}
 
 	name := fmt.Sprintf("New%s%s", constructorPrefix, iface.mockStructName)
@@ -19,6 +19,8 @@ func generateMockStructConstructor(iface *wrappedInterface, constructorPrefix, o
 		fmt.Sprintf(`%s creates a new mock of the %s interface.`, name, iface.Name),
 		`All methods return zero values for all results, unless overwritten.`,
 	}
+	commentText = append(commentText, defaultValuesCommentText(iface)...)
+	commentText = append(commentText, resultMocksCommentText(iface)...)
 	return generateConstructor(iface, strings.Join(commentText, " "), name, nil, outputImportPath, makeField)
 }
 
@@ -66,6 +68,296 @@ func generateMockStructFromConstructorCommon(iface *wrappedInterface, ifaceName
 	return generateConstructor(iface, strings.Join(commentText, " "), name, params, outputImportPath, makeField)
 }
 
+func generateMockStructWithConstructor(iface *wrappedInterface, constructorPrefix, outputImportPath string) jen.Code {
+	if !iface.withConstructors {
+		return jen.Null()
+	}
+
+	makeField := func(method *wrappedMethod) jen.Code {
+		// funcs.<MethodName>
+		return makeDefaultHookField(iface, method, outputImportPath, jen.Id("funcs").Dot(method.Name))
+	}
+
+	name := fmt.Sprintf("New%s%sWith", constructorPrefix, iface.mockStructName)
+	commentText := []string{
+		fmt.Sprintf(`%s creates a new mock of the %s interface.`, name, iface.Name),
+		`All methods call the corresponding hook of the given funcs, after the given options have been applied to it, unless overwritten.`,
+		`Methods without a hook return zero values for all results.`,
+	}
+	commentText = append(commentText, defaultValuesCommentText(iface)...)
+	commentText = append(commentText, resultMocksCommentText(iface)...)
+
+	// for _, option := range options { option(&funcs) }
+	applyStatement := jen.For(jen.Id("_").Op(",").Id("option").Op(":=").Range().Id("options")).Block(jen.Id("option").Call(jen.Op("&").Id("funcs")))
+
+	defaultStatements := make([]jen.Code, 0, len(iface.wrappedMethods))
+	for _, method := range iface.wrappedMethods {
+		if iface.returnsMocks(method) {
+			// Nested mocks are returned when no default hook is set
+			continue
+		}
+
+		// if funcs.<MethodName> == nil { funcs.<MethodName> = func(...) (r0 <typ1>, ...) { return } }
+		hookExpression := jen.Id("funcs").Dot(method.Name)
+		assignStatement := compose(hookExpression.Clone().Op("="), generateNoopFunction(iface, method, outputImportPath))
+		defaultStatements = append(defaultStatements, jen.If(hookExpression.Clone().Op("==").Nil()).Block(assignStatement))
+	}
+
+	// return &Mock<Name>{ <constructorField>, ... }
+	returnStatement := compose(jen.Return(), generateConstructorInitializer(iface, outputImportPath, makeField))
+
+	body := []jen.Code{applyStatement, jen.Line()}   // for _, option := range options { option(&funcs) }
+	body = append(body, defaultStatements...)        // if funcs.<MethodName> == nil { funcs.<MethodName> = func(...) (...) { return } }
+	body = append(body, jen.Line(), returnStatement) // return &Mock<Name>{ <constructorField>, ... }
+
+	// (funcs Mock<Name>Funcs, options ...Mock<Name>Option)
+	params := []jen.Code{
+		compose(jen.Id("funcs"), addTypes(jen.Id(fmt.Sprintf("%sFuncs", iface.mockStructName)), iface.TypeParams, outputImportPath, false)),
+		compose(jen.Id("options").Op("..."), addTypes(jen.Id(fmt.Sprintf("%sOption", iface.mockStructName)), iface.TypeParams, outputImportPath, false)),
+	}
+	return generateConstructorFunction(iface, strings.Join(commentText, " "), name, params, outputImportPath, body...)
+}
+
+func generateMockOptionFunctions(iface *wrappedInterface, constructorPrefix, outputImportPath string) jen.Code {
+	if !iface.withConstructors {
+		return jen.Null()
+	}
+
+	mockFuncsStructName := fmt.Sprintf("%sFuncs", iface.mockStructName)
+	mockOptionTypeName := fmt.Sprintf("%sOption", iface.mockStructName)
+
+	functions := make([]jen.Code, 0, len(iface.wrappedMethods))
+	for _, method := range iface.wrappedMethods {
+		name := fmt.Sprintf("With%s%s%s", iface.prefix, iface.titleName, method.Name)
+		commentText := fmt.Sprintf(
+			`%s returns an option that sets the default hook of the method %s of a mock constructed by New%s%sWith.`,
+			name,
+			method.Name,
+			constructorPrefix,
+			iface.mockStructName,
+		)
+
+		// return func(funcs *Mock<Name>Funcs) { funcs.<MethodName> = hook }
+		funcsType := compose(jen.Op("*"), addTypes(jen.Id(mockFuncsStructName), iface.TypeParams, outputImportPath, false))
+		assignStatement := jen.Id("funcs").Dot(method.Name).Op("=").Id("hook")
+		returnStatement := jen.Return(jen.Func().Params(compose(jen.Id("funcs"), funcsType)).Block(assignStatement))
+
+		params := []jen.Code{compose(jen.Id("hook"), method.signature)}
+		results := []jen.Code{addTypes(jen.Id(mockOptionTypeName), iface.TypeParams, outputImportPath, false)}
+		functionDeclaration := compose(addTypes(jen.Func().Id(name), iface.TypeParams, outputImportPath, true), jen.Params(params...).Params(results...).Block(returnStatement))
+		if len(functions) != 0 {
+			functions = append(functions, jen.Line(), jen.Line())
+		}
+		functions = append(functions, addComment(functionDeclaration, 1, commentText))
+	}
+
+	return compose(jen.Null(), functions...)
+}
+
+func generateMockStructRandomConstructor(iface *wrappedInterface, constructorPrefix, outputImportPath string) jen.Code {
+	if !iface.randomConstructors {
+		return jen.Null()
+	}
+
+	makeField := func(method *wrappedMethod) jen.Code {
+		return makeDefaultHookField(iface, method, outputImportPath, generateRandomFunction(iface, method, outputImportPath))
+	}
+
+	name := fmt.Sprintf("NewRandom%s%s", constructorPrefix, iface.mockStructName)
+	commentText := []string{
+		fmt.Sprintf(`%s creates a new mock of the %s interface.`, name, iface.Name),
+		`All methods return arbitrary values for all results, unless overwritten.`,
+		`Interface, function, and channel values are left nil.`,
+		`The values returned by each method are deterministic for the given seed.`,
+	}
+
+	// return &Mock<Name>{ <constructorField>, ... }
+	returnStatement := compose(jen.Return(), generateConstructorInitializer(iface, outputImportPath, makeField))
+
+	for _, method := range iface.wrappedMethods {
+		if len(method.resultTypes) != 0 {
+			// random := mocksupport.NewRandom(seed)
+			randomDeclaration := jen.Id("random").Op(":=").Qual(supportImportPath, "NewRandom").Call(jen.Id("seed"))
+			returnStatement = compose(randomDeclaration, jen.Line(), returnStatement)
+			break
+		}
+	}
+
+	params := []jen.Code{jen.Id("seed").Int64()}
+	return generateConstructorFunction(iface, strings.Join(commentText, " "), name, params, outputImportPath, returnStatement)
+}
+
+func generateMockStructTestConstructor(iface *wrappedInterface, constructorPrefix, outputImportPath string) jen.Code {
+	if !iface.testConstructors {
+		return jen.Null()
+	}
+
+	makeField := func(method *wrappedMethod) jen.Code {
+		return makeNoopHookField(iface, method, outputImportPath)
+	}
+
+	name := fmt.Sprintf("New%s%sT", constructorPrefix, iface.mockStructName)
+	commentText := []string{
+		fmt.Sprintf(`%s creates a new mock of the %s interface bound to the given test.`, name, iface.Name),
+		`All methods return zero values for all results, unless overwritten.`,
+	}
+	commentText = append(commentText, defaultValuesCommentText(iface)...)
+	commentText = append(commentText, resultMocksCommentText(iface)...)
+	commentText = append(commentText, testCleanupCommentText(iface)...)
+
+	params := []jen.Code{jen.Id("t").Qual("testing", "TB")}
+	return generateTestConstructor(iface, strings.Join(commentText, " "), name, params, outputImportPath, makeField)
+}
+
+func generateMockStructStrictTestConstructor(iface *wrappedInterface, constructorPrefix, outputImportPath string) jen.Code {
+	if !iface.testConstructors {
+		return jen.Null()
+	}
+
+	makeField := func(method *wrappedMethod) jen.Code {
+		return makeDefaultHookField(iface, method, outputImportPath, generateErroringFunction(iface, method, outputImportPath))
+	}
+
+	name := fmt.Sprintf("NewStrict%s%sT", constructorPrefix, iface.mockStructName)
+	commentText := []string{
+		fmt.Sprintf(`%s creates a new mock of the %s interface bound to the given test.`, name, iface.Name),
+		`All methods fail the test and return zero values for all results on invocation, unless overwritten.`,
+	}
+	commentText = append(commentText, testCleanupCommentText(iface)...)
+
+	params := []jen.Code{jen.Id("t").Qual("testing", "TB")}
+	return generateTestConstructor(iface, strings.Join(commentText, " "), name, params, outputImportPath, makeField)
+}
+
+func generateMockStructFromTestConstructor(iface *wrappedInterface, constructorPrefix, outputImportPath string) jen.Code {
+	if !iface.testConstructors {
+		return jen.Null()
+	}
+
+	ifaceName := jen.Qual(sanitizeImportPath(iface.ImportPath, outputImportPath), iface.Name)
+	if !unicode.IsUpper([]rune(iface.Name)[0]) {
+		// Surrogate interface is defined alongside the non-test From constructor
+		ifaceName = jen.Id(fmt.Sprintf("surrogateMock%s", iface.titleName))
+	}
+
+	makeField := func(method *wrappedMethod) jen.Code {
+		// i.<MethodName>
+		return makeDefaultHookField(iface, method, outputImportPath, jen.Id("i").Dot(method.Name))
+	}
+
+	name := fmt.Sprintf("New%s%sFromT", constructorPrefix, iface.mockStructName)
+	commentText := []string{
+		fmt.Sprintf(`%s creates a new mock of the %s interface bound to the given test.`, name, iface.mockStructName),
+		`All methods delegate to the given implementation, unless overwritten.`,
+	}
+	commentText = append(commentText, testCleanupCommentText(iface)...)
+
+	// (t testing.TB, i <InterfaceName>)
+	params := []jen.Code{
+		jen.Id("t").Qual("testing", "TB"),
+		compose(jen.Id("i"), addTypes(ifaceName, iface.TypeParams, outputImportPath, false)),
+	}
+	return generateTestConstructor(iface, strings.Join(commentText, " "), name, params, outputImportPath, makeField)
+}
+
+func generateMockStructRecordingConstructor(iface *wrappedInterface, constructorPrefix, outputImportPath string) jen.Code {
+	if !iface.recording {
+		return jen.Null()
+	}
+
+	ifaceName := jen.Qual(sanitizeImportPath(iface.ImportPath, outputImportPath), iface.Name)
+	if !unicode.IsUpper([]rune(iface.Name)[0]) {
+		// Surrogate interface is defined alongside the non-test From constructor
+		ifaceName = jen.Id(fmt.Sprintf("surrogateMock%s", iface.titleName))
+	}
+
+	makeField := func(method *wrappedMethod) jen.Code {
+		return makeDefaultHookField(iface, method, outputImportPath, generateRecordingFunction(iface, method, outputImportPath))
+	}
+
+	name := fmt.Sprintf("NewRecording%s%s", constructorPrefix, iface.mockStructName)
+	commentText := []string{
+		fmt.Sprintf(`%s creates a new mock of the %s interface.`, name, iface.mockStructName),
+		`All methods delegate to the given implementation, unless overwritten, and the arguments and results of each delegated invocation are added to the given recorder.`,
+		fmt.Sprintf(`The fixture written by the recorder can be replayed by New%s%sFromRecording.`, constructorPrefix, iface.mockStructName),
+	}
+
+	// (i <InterfaceName>, recorder *mocksupport.Recorder)
+	params := []jen.Code{
+		compose(jen.Id("i"), addTypes(ifaceName, iface.TypeParams, outputImportPath, false)),
+		jen.Id("recorder").Op("*").Qual(supportImportPath, "Recorder"),
+	}
+	return generateConstructor(iface, strings.Join(commentText, " "), name, params, outputImportPath, makeField)
+}
+
+func generateMockStructFromRecordingConstructor(iface *wrappedInterface, constructorPrefix, outputImportPath string) jen.Code {
+	if !iface.recording {
+		return jen.Null()
+	}
+
+	makeField := func(method *wrappedMethod) jen.Code {
+		return makeDefaultHookField(iface, method, outputImportPath, generateReplayingFunction(iface, method, outputImportPath))
+	}
+
+	name := fmt.Sprintf("New%s%sFromRecording", constructorPrefix, iface.mockStructName)
+	commentText := []string{
+		fmt.Sprintf(`%s creates a new mock of the %s interface from the JSON fixture written by a recorder passed to NewRecording%s%s.`, name, iface.mockStructName, constructorPrefix, iface.mockStructName),
+		`All methods return the results recorded for matching arguments, unless overwritten.`,
+		`Invocations with arguments that were never recorded are reported to the given test via t.Errorf and return zero values.`,
+		`Recorded results are decoded from JSON into the declared result types, so values held in an interface type other than error are replayed as generic JSON values (float64, string, bool, []interface{}, or map[string]interface{}), and errors are replayed as values created by errors.New with the recorded message, which errors.Is and errors.As do not match against the original errors.`,
+	}
+
+	recordingName := "recording"
+	if len(iface.wrappedMethods) == 0 {
+		// The recording is still loaded so that a missing fixture is reported
+		recordingName = "_"
+	}
+
+	// recording, err := mocksupport.LoadRecording(filename)
+	loadStatement := jen.List(jen.Id(recordingName), jen.Err()).Op(":=").Qual(supportImportPath, "LoadRecording").Call(jen.Id("filename"))
+	// if err != nil { return nil, err }
+	errorCheck := jen.If(jen.Err().Op("!=").Nil()).Block(jen.Return(jen.Nil(), jen.Err()))
+	// return &Mock<Name>{ <constructorField>, ... }, nil
+	returnStatement := jen.Return(generateConstructorInitializer(iface, outputImportPath, makeField), jen.Nil())
+
+	params := []jen.Code{jen.Id("t").Qual("testing", "TB"), jen.Id("filename").String()}
+	results := []jen.Code{addTypes(jen.Op("*").Id(iface.mockStructName), iface.TypeParams, outputImportPath, false), jen.Error()}
+	body := []jen.Code{loadStatement, errorCheck, jen.Line(), returnStatement}
+	functionDeclaration := compose(addTypes(jen.Func().Id(name), iface.TypeParams, outputImportPath, true), jen.Params(params...).Params(results...).Block(body...))
+	return addComment(functionDeclaration, 1, strings.Join(commentText, " "))
+}
+
+func generateMockInterfaceAssertion(iface *wrappedInterface, outputImportPath string) jen.Code {
+	if !iface.interfaceAssertions {
+		return jen.Null()
+	}
+
+	ifaceName := jen.Qual(sanitizeImportPath(iface.ImportPath, outputImportPath), iface.Name)
+	if !unicode.IsUpper([]rune(iface.Name)[0]) {
+		// Surrogate interface is defined alongside the non-test From constructor
+		ifaceName = jen.Id(fmt.Sprintf("surrogateMock%s", iface.titleName))
+	}
+
+	commentText := fmt.Sprintf(
+		`%s must implement the %s interface (from the package %s). This declaration fails to compile if the interface changes and the mock is not regenerated.`,
+		iface.mockStructName,
+		iface.Name,
+		iface.ImportPath,
+	)
+
+	// var _ <InterfaceName> = (*<MockStructName>)(nil)
+	mockType := compose(jen.Op("*"), addTypes(jen.Id(iface.mockStructName), iface.TypeParams, outputImportPath, false))
+	assertion := jen.Var().Id("_").Add(addTypes(ifaceName, iface.TypeParams, outputImportPath, false)).Op("=").Parens(mockType).Call(jen.Nil())
+
+	if len(iface.TypeParams) > 0 {
+		// Generic types can only be instantiated with their own type parameters within a generic function
+		// func _[T <Constraint>, ...]() { var _ <InterfaceName>[T, ...] = (*<MockStructName>[T, ...])(nil) }
+		assertion = addTypes(jen.Func().Id("_"), iface.TypeParams, outputImportPath, true).Params().Block(assertion)
+	}
+
+	return addComment(assertion, 1, commentText)
+}
+
 func generateConstructor(
 	iface *wrappedInterface,
 	commentText string,
@@ -74,18 +366,115 @@ func generateConstructor(
 	outputImportPath string,
 	makeField func(method *wrappedMethod) jen.Code,
 ) jen.Code {
+	// return &Mock<Name>{ <constructorField>, ... }
+	returnStatement := compose(jen.Return(), generateConstructorInitializer(iface, outputImportPath, makeField))
+	return generateConstructorFunction(iface, commentText, methodName, params, outputImportPath, returnStatement)
+}
+
+func generateTestConstructor(
+	iface *wrappedInterface,
+	commentText string,
+	methodName string,
+	params []jen.Code,
+	outputImportPath string,
+	makeField func(method *wrappedMethod) jen.Code,
+) jen.Code {
+	var assertStatements []jen.Code
+	if iface.expectations {
+		assertStatements = append(assertStatements, jen.Id("m").Dot("AssertExpectations").Call(jen.Id("t")))
+	}
+	if iface.pendingHooks {
+		assertStatements = append(assertStatements, jen.Id("m").Dot("AssertAllHooksConsumed").Call(jen.Id("t")))
+	}
+	if len(assertStatements) == 0 {
+		// There is nothing to assert when the test completes
+		return generateConstructor(iface, commentText, methodName, params, outputImportPath, makeField)
+	}
+
+	mockDeclaration := compose(jen.Id("m").Op(":="), generateConstructorInitializer(iface, outputImportPath, makeField))
+	cleanupStatement := jen.Id("t").Dot("Cleanup").Call(jen.Func().Params().Block(assertStatements...))
+	returnStatement := jen.Return(jen.Id("m"))
+
+	return generateConstructorFunction(iface, commentText, methodName, params, outputImportPath,
+		mockDeclaration, jen.Line(), // m := &Mock<Name>{ <constructorField>, ... }
+		cleanupStatement, // t.Cleanup(func() { [m.AssertExpectations(t);] [m.AssertAllHooksConsumed(t)] })
+		returnStatement,  // return m
+	)
+}
+
+func generateConstructorInitializer(iface *wrappedInterface, outputImportPath string, makeField func(method *wrappedMethod) jen.Code) jen.Code {
 	constructorFields := make([]jen.Code, 0, len(iface.Methods))
 	for _, method := range iface.wrappedMethods {
 		constructorFields = append(constructorFields, makeField(method))
 	}
 
-	// return &Mock<Name>{ <constructorField>, ... }
-	returnStatement := compose(jen.Return(), generateStructInitializer(iface.mockStructName, outputImportPath, iface.TypeParams, constructorFields...))
+	// &Mock<Name>{ <constructorField>, ... }
+	return generateStructInitializer(iface.mockStructName, outputImportPath, iface.TypeParams, constructorFields...)
+}
+
+func generateConstructorFunction(
+	iface *wrappedInterface,
+	commentText string,
+	methodName string,
+	params []jen.Code,
+	outputImportPath string,
+	body ...jen.Code,
+) jen.Code {
 	results := []jen.Code{addTypes(jen.Op("*").Id(iface.mockStructName), iface.TypeParams, outputImportPath, false)}
-	functionDeclaration := compose(addTypes(jen.Func().Id(methodName), iface.TypeParams, outputImportPath, true), jen.Params(params...).Params(results...).Block(returnStatement))
+	functionDeclaration := compose(addTypes(jen.Func().Id(methodName), iface.TypeParams, outputImportPath, true), jen.Params(params...).Params(results...).Block(body...))
 	return addComment(functionDeclaration, 1, commentText)
 }
 
+// makeNoopHookField returns the constructor field for the given method that returns
+// zero values for all results. Methods returning an interface mocked in the same output
+// are left without a default hook so that they return nested mocks.
+func makeNoopHookField(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.returnsMocks(method) {
+		return makeDefaultHookField(iface, method, outputImportPath, generateNoopFunction(iface, method, outputImportPath))
+	}
+
+	fieldName := fmt.Sprintf("%sFunc", method.Name)
+	structName := fmt.Sprintf("%s%s%sFunc", iface.prefix, iface.titleName, method.Name)
+
+	// <fieldName>: &StructName{}
+	return compose(jen.Id(fieldName), jen.Op(":"), addTypes(jen.Op("&").Id(structName), iface.TypeParams, outputImportPath, false), jen.Values())
+}
+
+func defaultValuesCommentText(iface *wrappedInterface) []string {
+	for _, method := range iface.wrappedMethods {
+		for i := range method.Results {
+			if _, ok := iface.defaultValue(method, i, ""); ok {
+				return []string{`Results of a type with a configured default value are that value instead of the zero value.`}
+			}
+		}
+	}
+
+	return nil
+}
+
+func testCleanupCommentText(iface *wrappedInterface) []string {
+	switch {
+	case iface.expectations && iface.pendingHooks:
+		return []string{`Expectations and hook queues are asserted when the test completes.`}
+	case iface.expectations:
+		return []string{`Expectations are asserted when the test completes.`}
+	case iface.pendingHooks:
+		return []string{`Hook queues are asserted when the test completes.`}
+	}
+
+	return nil
+}
+
+func resultMocksCommentText(iface *wrappedInterface) []string {
+	for _, method := range iface.wrappedMethods {
+		if iface.returnsMocks(method) {
+			return []string{`Results of an interface type mocked in the same package are nested mocks instead of nil values, and results of the mocked interface itself are this mock.`}
+		}
+	}
+
+	return nil
+}
+
 func generateNoopFunction(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
 	rt := make([]jen.Code, 0, len(method.resultTypes))
 	for i, resultType := range method.resultTypes {
@@ -93,8 +482,31 @@ func generateNoopFunction(iface *wrappedInterface, method *wrappedMethod, output
 		rt = append(rt, compose(jen.Id(fmt.Sprintf("r%d", i)), resultType))
 	}
 
-	// Note: an empty return here returns the zero valued variables r0, r1, ...
-	return jen.Func().Params(method.paramTypes...).Params(rt...).Block(jen.Return())
+	// Note: an empty return here returns the variables r0, r1, ..., which hold zero
+	// values unless a default value is configured for their type
+	body := append(generateDefaultValueAssignments(iface, method, outputImportPath), jen.Return())
+	return jen.Func().Params(method.paramTypes...).Params(rt...).Block(body...)
+}
+
+func generateRandomFunction(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	rt := make([]jen.Code, 0, len(method.resultTypes))
+	fillArgs := []jen.Code{jen.Lit(method.Name)}
+	for i, resultType := range method.resultTypes {
+		// (r0 <typ1>, r1 <type2>, ...)
+		name := fmt.Sprintf("r%d", i)
+		rt = append(rt, compose(jen.Id(name), resultType))
+		fillArgs = append(fillArgs, jen.Op("&").Id(name))
+	}
+
+	if len(rt) == 0 {
+		return jen.Func().Params(method.paramTypes...).Block(jen.Return())
+	}
+
+	// random.Fill("<MethodName>", &r0, &r1, ...)
+	fillStatement := jen.Id("random").Dot("Fill").Call(fillArgs...)
+
+	// Note: an empty return here returns the populated variables r0, r1, ...
+	return jen.Func().Params(method.paramTypes...).Params(rt...).Block(fillStatement, jen.Return())
 }
 
 func generatePanickingFunction(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
@@ -103,6 +515,106 @@ func generatePanickingFunction(iface *wrappedInterface, method *wrappedMethod, o
 	return jen.Func().Params(method.paramTypes...).Params(method.resultTypes...).Block(panicStatement)
 }
 
+func generateErroringFunction(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	params := make([]jen.Code, 0, len(method.paramTypes))
+	names := make([]jen.Code, 0, len(method.paramTypes))
+	for i, paramType := range method.paramTypes {
+		// (v0 <type1>, v1 <type2>, ...)
+		name := jen.Id(fmt.Sprintf("v%d", i))
+		params = append(params, compose(name, paramType))
+		names = append(names, name)
+	}
+
+	rt := make([]jen.Code, 0, len(method.resultTypes))
+	for i, resultType := range method.resultTypes {
+		// (r0 <typ1>, r1 <type2>, ...)
+		rt = append(rt, compose(jen.Id(fmt.Sprintf("r%d", i)), resultType))
+	}
+
+	// mocksupport.ReportUnexpectedCall(t, "<Struct>.<Method>", []interface{}{v0, v1, ...})
+	name := jen.Lit(fmt.Sprintf("%s.%s", iface.mockStructName, method.Method.Name))
+	reportStatement := jen.Qual(supportImportPath, "ReportUnexpectedCall").Call(jen.Id("t"), name, jen.Index().Interface().Values(names...))
+
+	// Note: an empty return here returns the zero valued variables r0, r1, ...
+	return jen.Func().Params(params...).Params(rt...).Block(reportStatement, jen.Return())
+}
+
+func generateRecordingFunction(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	params := make([]jen.Code, 0, len(method.paramTypes))
+	arguments := make([]jen.Code, 0, len(method.paramTypes))
+	argPointers := make([]jen.Code, 0, len(method.paramTypes))
+	for i, paramType := range method.paramTypes {
+		// (v0 <type1>, v1 <type2>, ...)
+		name := fmt.Sprintf("v%d", i)
+		params = append(params, compose(jen.Id(name), paramType))
+		argPointers = append(argPointers, jen.Op("&").Id(name))
+
+		argument := jen.Id(name)
+		if method.Variadic && i == len(method.paramTypes)-1 {
+			argument = argument.Op("...")
+		}
+		arguments = append(arguments, argument)
+	}
+
+	resultNames := make([]jen.Code, 0, len(method.resultTypes))
+	resultPointers := make([]jen.Code, 0, len(method.resultTypes))
+	for i := range method.resultTypes {
+		name := fmt.Sprintf("r%d", i)
+		resultNames = append(resultNames, jen.Id(name))
+		resultPointers = append(resultPointers, jen.Op("&").Id(name))
+	}
+
+	// i.<MethodName>(v0, v1, ...)
+	callStatement := jen.Id("i").Dot(method.Name).Call(arguments...)
+	results := jen.Code(jen.Nil())
+	returnStatement := jen.Null()
+	if len(resultNames) != 0 {
+		// r0, r1, ... := i.<MethodName>(v0, v1, ...)
+		callStatement = jen.List(resultNames...).Op(":=").Add(callStatement)
+		results = jen.Index().Interface().Values(resultPointers...)
+		returnStatement = jen.Return(resultNames...)
+	}
+
+	// recorder.Record("<MethodName>", []interface{}{&v0, ...}, []interface{}{&r0, ...})
+	recordStatement := jen.Id("recorder").Dot("Record").Call(jen.Lit(method.Name), jen.Index().Interface().Values(argPointers...), results)
+	return jen.Func().Params(params...).Params(method.resultTypes...).Block(callStatement, recordStatement, returnStatement)
+}
+
+func generateReplayingFunction(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	params := make([]jen.Code, 0, len(method.paramTypes))
+	names := make([]jen.Code, 0, len(method.paramTypes))
+	argPointers := make([]jen.Code, 0, len(method.paramTypes))
+	for i, paramType := range method.paramTypes {
+		// (v0 <type1>, v1 <type2>, ...)
+		name := fmt.Sprintf("v%d", i)
+		params = append(params, compose(jen.Id(name), paramType))
+		names = append(names, jen.Id(name))
+		argPointers = append(argPointers, jen.Op("&").Id(name))
+	}
+
+	rt := make([]jen.Code, 0, len(method.resultTypes))
+	replayArgs := []jen.Code{jen.Lit(method.Name), jen.Index().Interface().Values(argPointers...)}
+	for i, resultType := range method.resultTypes {
+		// (r0 <typ1>, r1 <type2>, ...)
+		name := fmt.Sprintf("r%d", i)
+		rt = append(rt, compose(jen.Id(name), resultType))
+		replayArgs = append(replayArgs, jen.Op("&").Id(name))
+	}
+
+	// recording.Replay("<MethodName>", []interface{}{&v0, ...}, &r0, ...)
+	replayExpression := jen.Id("recording").Dot("Replay").Call(replayArgs...)
+
+	// mocksupport.ReportUnexpectedCall(t, "<Struct>.<Method>", []interface{}{v0, v1, ...})
+	name := jen.Lit(fmt.Sprintf("%s.%s", iface.mockStructName, method.Method.Name))
+	reportStatement := jen.Qual(supportImportPath, "ReportUnexpectedCall").Call(jen.Id("t"), name, jen.Index().Interface().Values(names...))
+
+	// if !recording.Replay(...) { mocksupport.ReportUnexpectedCall(...) }
+	replayStatement := jen.If(jen.Op("!").Add(replayExpression)).Block(reportStatement)
+
+	// Note: an empty return here returns the replayed variables r0, r1, ...
+	return jen.Func().Params(params...).Params(rt...).Block(replayStatement, jen.Return())
+}
+
 func generateSurrogateInterface(iface *wrappedInterface, surrogateName, outputImportPath string) *jen.Statement {
 	surrogateCommentText := strings.Join([]string{
 		fmt.Sprintf(`%s is a copy of the %s interface (from the package %s).`, surrogateName, iface.Name, iface.ImportPath),
@@ -2,8 +2,10 @@ package generation
 
 import (
 	"fmt"
+	gotypes "go/types"
 	"testing"
 
+	"github.com/derision-test/go-mockgen/v2/internal/mockgen/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -118,3 +120,492 @@ func TestGenerateMockStructFromConstructorUnexported(t *testing.T) {
 	`)
 	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
 }
+
+func TestGenerateMockStructTestConstructor(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.testConstructors = true
+	wrappedInterface.pendingHooks = true
+	code := generateMockStructTestConstructor(wrappedInterface, "", "")
+	expected := strip(`
+		// NewMockTestClientT creates a new mock of the Client interface bound to
+		// the given test. All methods return zero values for all results, unless
+		// overwritten. Hook queues are asserted when the test completes.
+		func NewMockTestClientT(t testing.TB) *MockTestClient {
+			m := &MockTestClient{
+				StatusFunc: &TestClientStatusFunc{
+					defaultHook: func() (r0 string, r1 bool) {
+						return
+					},
+				},
+				DoFunc: &TestClientDoFunc{
+					defaultHook: func(string) (r0 bool) {
+						return
+					},
+				},
+				DofFunc: &TestClientDofFunc{
+					defaultHook: func(string, ...string) (r0 bool) {
+						return
+					},
+				},
+			}
+
+			t.Cleanup(func() {
+				m.AssertAllHooksConsumed(t)
+			})
+			return m
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockStructStrictTestConstructor(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.testConstructors = true
+	code := generateMockStructStrictTestConstructor(wrappedInterface, "", "")
+	expected := strip(`
+		// NewStrictMockTestClientT creates a new mock of the Client interface bound
+		// to the given test. All methods fail the test and return zero values for
+		// all results on invocation, unless overwritten.
+		func NewStrictMockTestClientT(t testing.TB) *MockTestClient {
+			return &MockTestClient{
+				StatusFunc: &TestClientStatusFunc{
+					defaultHook: func() (r0 string, r1 bool) {
+						mocksupport.ReportUnexpectedCall(t, "MockTestClient.Status", []interface{}{})
+						return
+					},
+				},
+				DoFunc: &TestClientDoFunc{
+					defaultHook: func(v0 string) (r0 bool) {
+						mocksupport.ReportUnexpectedCall(t, "MockTestClient.Do", []interface{}{v0})
+						return
+					},
+				},
+				DofFunc: &TestClientDofFunc{
+					defaultHook: func(v0 string, v1 ...string) (r0 bool) {
+						mocksupport.ReportUnexpectedCall(t, "MockTestClient.Dof", []interface{}{v0, v1})
+						return
+					},
+				},
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockStructFromTestConstructor(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.testConstructors = true
+	wrappedInterface.pendingHooks = true
+	code := generateMockStructFromTestConstructor(wrappedInterface, "", "")
+	expected := strip(`
+		// NewMockTestClientFromT creates a new mock of the MockTestClient interface
+		// bound to the given test. All methods delegate to the given
+		// implementation, unless overwritten. Hook queues are asserted when the
+		// test completes.
+		func NewMockTestClientFromT(t testing.TB, i test.Client) *MockTestClient {
+			m := &MockTestClient{
+				StatusFunc: &TestClientStatusFunc{
+					defaultHook: i.Status,
+				},
+				DoFunc: &TestClientDoFunc{
+					defaultHook: i.Do,
+				},
+				DofFunc: &TestClientDofFunc{
+					defaultHook: i.Dof,
+				},
+			}
+
+			t.Cleanup(func() {
+				m.AssertAllHooksConsumed(t)
+			})
+			return m
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockInterfaceAssertion(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.interfaceAssertions = true
+	code := generateMockInterfaceAssertion(wrappedInterface, "")
+	expected := strip(`
+		// MockTestClient must implement the Client interface (from the package
+		// github.com/derision-test/go-mockgen/v2/test). This declaration fails to
+		// compile if the interface changes and the mock is not regenerated.
+		var _ test.Client = (*MockTestClient)(nil)
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockInterfaceAssertionUnexported(t *testing.T) {
+	iface := makeBareInterface(TestMethodDo)
+	iface.Name = "client"
+	wrappedInterface := wrapInterface(iface, TestPrefix, TestTitleName, TestMockStructName, "")
+	wrappedInterface.interfaceAssertions = true
+	code := generateMockInterfaceAssertion(wrappedInterface, "")
+	expected := strip(`
+		// MockTestClient must implement the client interface (from the package
+		// github.com/derision-test/go-mockgen/v2/test). This declaration fails to
+		// compile if the interface changes and the mock is not regenerated.
+		var _ surrogateMockClient = (*MockTestClient)(nil)
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockInterfaceAssertionGeneric(t *testing.T) {
+	iface := makeBareInterface(TestMethodDo)
+	iface.TypeParams = []types.TypeParam{{Name: "T", Type: gotypes.Universe.Lookup("any").Type()}}
+	wrappedInterface := wrapInterface(iface, TestPrefix, TestTitleName, TestMockStructName, "")
+	wrappedInterface.interfaceAssertions = true
+	code := generateMockInterfaceAssertion(wrappedInterface, "")
+	expected := strip(`
+		// MockTestClient must implement the Client interface (from the package
+		// github.com/derision-test/go-mockgen/v2/test). This declaration fails to
+		// compile if the interface changes and the mock is not regenerated.
+		func _[T interface{}]() {
+			var _ test.Client[T] = (*MockTestClient[T])(nil)
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockStructRecordingConstructor(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.recording = true
+	code := generateMockStructRecordingConstructor(wrappedInterface, "", "")
+	expected := strip(`
+		// NewRecordingMockTestClient creates a new mock of the MockTestClient
+		// interface. All methods delegate to the given implementation, unless
+		// overwritten, and the arguments and results of each delegated invocation
+		// are added to the given recorder. The fixture written by the recorder can
+		// be replayed by NewMockTestClientFromRecording.
+		func NewRecordingMockTestClient(i test.Client, recorder *mocksupport.Recorder) *MockTestClient {
+			return &MockTestClient{
+				StatusFunc: &TestClientStatusFunc{
+					defaultHook: func() (string, bool) {
+						r0, r1 := i.Status()
+						recorder.Record("Status", []interface{}{}, []interface{}{&r0, &r1})
+						return r0, r1
+					},
+				},
+				DoFunc: &TestClientDoFunc{
+					defaultHook: func(v0 string) bool {
+						r0 := i.Do(v0)
+						recorder.Record("Do", []interface{}{&v0}, []interface{}{&r0})
+						return r0
+					},
+				},
+				DofFunc: &TestClientDofFunc{
+					defaultHook: func(v0 string, v1 ...string) bool {
+						r0 := i.Dof(v0, v1...)
+						recorder.Record("Dof", []interface{}{&v0, &v1}, []interface{}{&r0})
+						return r0
+					},
+				},
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockStructFromRecordingConstructor(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.recording = true
+	code := generateMockStructFromRecordingConstructor(wrappedInterface, "", "")
+	expected := strip(`
+		// NewMockTestClientFromRecording creates a new mock of the MockTestClient
+		// interface from the JSON fixture written by a recorder passed to
+		// NewRecordingMockTestClient. All methods return the results recorded for
+		// matching arguments, unless overwritten. Invocations with arguments that
+		// were never recorded are reported to the given test via t.Errorf and
+		// return zero values. Recorded results are decoded from JSON into the
+		// declared result types, so values held in an interface type other than
+		// error are replayed as generic JSON values (float64, string, bool,
+		// []interface{}, or map[string]interface{}), and errors are replayed as
+		// values created by errors.New with the recorded message, which errors.Is
+		// and errors.As do not match against the original errors.
+		func NewMockTestClientFromRecording(t testing.TB, filename string) (*MockTestClient, error) {
+			recording, err := mocksupport.LoadRecording(filename)
+			if err != nil {
+				return nil, err
+			}
+
+			return &MockTestClient{
+				StatusFunc: &TestClientStatusFunc{
+					defaultHook: func() (r0 string, r1 bool) {
+						if !recording.Replay("Status", []interface{}{}, &r0, &r1) {
+							mocksupport.ReportUnexpectedCall(t, "MockTestClient.Status", []interface{}{})
+						}
+						return
+					},
+				},
+				DoFunc: &TestClientDoFunc{
+					defaultHook: func(v0 string) (r0 bool) {
+						if !recording.Replay("Do", []interface{}{&v0}, &r0) {
+							mocksupport.ReportUnexpectedCall(t, "MockTestClient.Do", []interface{}{v0})
+						}
+						return
+					},
+				},
+				DofFunc: &TestClientDofFunc{
+					defaultHook: func(v0 string, v1 ...string) (r0 bool) {
+						if !recording.Replay("Dof", []interface{}{&v0, &v1}, &r0) {
+							mocksupport.ReportUnexpectedCall(t, "MockTestClient.Dof", []interface{}{v0, v1})
+						}
+						return
+					},
+				},
+			}, nil
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockStructRandomConstructor(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.randomConstructors = true
+	code := generateMockStructRandomConstructor(wrappedInterface, "", "")
+	expected := strip(`
+		// NewRandomMockTestClient creates a new mock of the Client interface. All
+		// methods return arbitrary values for all results, unless overwritten.
+		// Interface, function, and channel values are left nil. The values returned
+		// by each method are deterministic for the given seed.
+		func NewRandomMockTestClient(seed int64) *MockTestClient {
+			random := mocksupport.NewRandom(seed)
+			return &MockTestClient{
+				StatusFunc: &TestClientStatusFunc{
+					defaultHook: func() (r0 string, r1 bool) {
+						random.Fill("Status", &r0, &r1)
+						return
+					},
+				},
+				DoFunc: &TestClientDoFunc{
+					defaultHook: func(string) (r0 bool) {
+						random.Fill("Do", &r0)
+						return
+					},
+				},
+				DofFunc: &TestClientDofFunc{
+					defaultHook: func(string, ...string) (r0 bool) {
+						random.Fill("Dof", &r0)
+						return
+					},
+				},
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockStructWithConstructor(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.withConstructors = true
+	code := generateMockStructWithConstructor(wrappedInterface, "", "")
+	expected := strip(`
+		// NewMockTestClientWith creates a new mock of the Client interface. All
+		// methods call the corresponding hook of the given funcs, after the given
+		// options have been applied to it, unless overwritten. Methods without a
+		// hook return zero values for all results.
+		func NewMockTestClientWith(funcs MockTestClientFuncs, options ...MockTestClientOption) *MockTestClient {
+			for _, option := range options {
+				option(&funcs)
+			}
+
+			if funcs.Status == nil {
+				funcs.Status = func() (r0 string, r1 bool) {
+					return
+				}
+			}
+			if funcs.Do == nil {
+				funcs.Do = func(string) (r0 bool) {
+					return
+				}
+			}
+			if funcs.Dof == nil {
+				funcs.Dof = func(string, ...string) (r0 bool) {
+					return
+				}
+			}
+
+			return &MockTestClient{
+				StatusFunc: &TestClientStatusFunc{
+					defaultHook: funcs.Status,
+				},
+				DoFunc: &TestClientDoFunc{
+					defaultHook: funcs.Do,
+				},
+				DofFunc: &TestClientDofFunc{
+					defaultHook: funcs.Dof,
+				},
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockOptionFunctions(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.withConstructors = true
+	code := generateMockOptionFunctions(wrappedInterface, "", "")
+	expected := strip(`
+		// WithTestClientStatus returns an option that sets the default hook of the
+		// method Status of a mock constructed by NewMockTestClientWith.
+		func WithTestClientStatus(hook func() (string, bool)) MockTestClientOption {
+			return func(funcs *MockTestClientFuncs) {
+				funcs.Status = hook
+			}
+		}
+
+		// WithTestClientDo returns an option that sets the default hook of the
+		// method Do of a mock constructed by NewMockTestClientWith.
+		func WithTestClientDo(hook func(string) bool) MockTestClientOption {
+			return func(funcs *MockTestClientFuncs) {
+				funcs.Do = hook
+			}
+		}
+
+		// WithTestClientDof returns an option that sets the default hook of the
+		// method Dof of a mock constructed by NewMockTestClientWith.
+		func WithTestClientDof(hook func(string, ...string) bool) MockTestClientOption {
+			return func(funcs *MockTestClientFuncs) {
+				funcs.Dof = hook
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockStructConstructorRecursiveMocks(t *testing.T) {
+	wrappedInterface := makeRecursiveInterface(TestMethodChild, TestMethodWith)
+	code := generateMockStructConstructor(wrappedInterface, "", "")
+	expected := strip(`
+		// NewMockTestClient creates a new mock of the Client interface. All methods
+		// return zero values for all results, unless overwritten. Results of an
+		// interface type mocked in the same package are nested mocks instead of nil
+		// values, and results of the mocked interface itself are this mock.
+		func NewMockTestClient() *MockTestClient {
+			return &MockTestClient{
+				ChildFunc: &TestClientChildFunc{},
+				WithFunc:  &TestClientWithFunc{},
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockStructConstructorDefaultValues(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodList, TestMethodStatus)
+	wrappedInterface.defaults = normalizeDefaults(map[string]string{"time.Time": "time.Unix(0, 0).UTC()", "error": "errors.New(\"unstubbed\")"})
+	wrappedInterface.emptyCollections = true
+	code := generateMockStructConstructor(wrappedInterface, "", "")
+	expected := strip(`
+		// NewMockTestClient creates a new mock of the Client interface. All methods
+		// return zero values for all results, unless overwritten. Results of a type
+		// with a configured default value are that value instead of the zero value.
+		func NewMockTestClient() *MockTestClient {
+			return &MockTestClient{
+				ListFunc: &TestClientListFunc{
+					defaultHook: func(string) (r0 []string, r1 map[string]bool, r2 time.Time, r3 error) {
+						r0 = []string{}
+						r1 = map[string]bool{}
+						r2 = time.Unix(0, 0).UTC()
+						r3 = errors.New("unstubbed")
+						return
+					},
+				},
+				StatusFunc: &TestClientStatusFunc{
+					defaultHook: func() (r0 string, r1 bool) {
+						return
+					},
+				},
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockStructConstructorDefaultValuesOutputPackage(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodList)
+	wrappedInterface.defaults = normalizeDefaults(map[string]string{"time.Time": "time.Unix(0, 0).UTC()"})
+	code := generateMockStructConstructor(wrappedInterface, "", "time")
+	expected := strip(`
+		// NewMockTestClient creates a new mock of the Client interface. All methods
+		// return zero values for all results, unless overwritten. Results of a type
+		// with a configured default value are that value instead of the zero value.
+		func NewMockTestClient() *MockTestClient {
+			return &MockTestClient{
+				ListFunc: &TestClientListFunc{
+					defaultHook: func(string) (r0 []string, r1 map[string]bool, r2 time.Time, r3 error) {
+						r2 = Unix(0, 0).UTC()
+						return
+					},
+				},
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockStructTestConstructorExpectations(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.expectations = true
+	wrappedInterface.testConstructors = true
+	wrappedInterface.pendingHooks = true
+	code := generateMockStructTestConstructor(wrappedInterface, "", "")
+	expected := strip(`
+		// NewMockTestClientT creates a new mock of the Client interface bound to
+		// the given test. All methods return zero values for all results, unless
+		// overwritten. Expectations and hook queues are asserted when the test
+		// completes.
+		func NewMockTestClientT(t testing.TB) *MockTestClient {
+			m := &MockTestClient{
+				StatusFunc: &TestClientStatusFunc{
+					defaultHook: func() (r0 string, r1 bool) {
+						return
+					},
+				},
+				DoFunc: &TestClientDoFunc{
+					defaultHook: func(string) (r0 bool) {
+						return
+					},
+				},
+				DofFunc: &TestClientDofFunc{
+					defaultHook: func(string, ...string) (r0 bool) {
+						return
+					},
+				},
+			}
+
+			t.Cleanup(func() {
+				m.AssertExpectations(t)
+				m.AssertAllHooksConsumed(t)
+			})
+			return m
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockStructRecordingConstructorsDisabled(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockStructRecordingConstructor(wrappedInterface, "", "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockStructFromRecordingConstructor(wrappedInterface, "", "")))
+}
+
+func TestGenerateMockStructRandomConstructorDisabled(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockStructRandomConstructor(wrappedInterface, "", "")))
+}
+
+func TestGenerateMockStructWithConstructorDisabled(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockStructWithConstructor(wrappedInterface, "", "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockOptionFunctions(wrappedInterface, "", "")))
+}
+
+func TestGenerateMockStructTestConstructorsDisabled(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockStructTestConstructor(wrappedInterface, "", "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockStructStrictTestConstructor(wrappedInterface, "", "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockStructFromTestConstructor(wrappedInterface, "", "")))
+}
@@ -20,7 +20,7 @@ func generateMockFuncCallArgsMethodNonVariadic(iface *wrappedInterface, method *
 
 	valueExpressions := make([]jen.Code, 0, len(method.Params))
 	for i := range method.Params {
-		valueExpressions = append(valueExpressions, jen.Id("c").Dot(fmt.Sprintf("Arg%d", i)))
+		valueExpressions = append(valueExpressions, jen.Id("c").Dot(method.argFieldNames[i]))
 	}
 	returnStatement := jen.Return().Index().Interface().Values(valueExpressions...)
 
@@ -38,7 +38,7 @@ func generateMockFuncCallArgsMethodVariadic(iface *wrappedInterface, method *wra
 
 	valueExpressions := make([]jen.Code, 0, len(method.Params))
 	for i := range method.Params {
-		valueExpressions = append(valueExpressions, jen.Id("c").Dot(fmt.Sprintf("Arg%d", i)))
+		valueExpressions = append(valueExpressions, jen.Id("c").Dot(method.argFieldNames[i]))
 	}
 
 	lastIndex := len(valueExpressions) - 1
@@ -73,6 +73,102 @@ func generateMockFuncCallResultsMethod(iface *wrappedInterface, method *wrappedM
 	)
 }
 
+func generateMockFuncCallSequenceMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.callSequence {
+		return jen.Null()
+	}
+
+	commentText := strings.Join([]string{
+		`Sequence returns the process-wide position of this invocation among the invocations of all mock functions.`,
+		`The sequence number is taken when the invocation begins, so comparing the sequence numbers of two invocations determines which began first, even across different mock instances and when one invocation begins and ends within the hook of another.`,
+	}, " ")
+
+	returnStatement := jen.Return(jen.Id("c").Dot("sequence"))
+
+	results := []jen.Code{jen.Uint64()}
+	return generateMockFuncCallMethod(iface, outputImportPath, method, "Sequence", commentText, nil, results,
+		returnStatement, // return c.sequence
+	)
+}
+
+func generateMockFuncCallPanicValueMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.recordPanics {
+		return jen.Null()
+	}
+
+	commentText := strings.Join([]string{
+		`PanicValue returns the value with which the hook handling this invocation panicked, or nil if the hook returned normally.`,
+		`The results of an invocation whose hook panicked are zero values.`,
+	}, " ")
+
+	returnStatement := jen.Return(jen.Id("c").Dot("panicValue"))
+
+	results := []jen.Code{jen.Interface()}
+	return generateMockFuncCallMethod(iface, outputImportPath, method, "PanicValue", commentText, nil, results,
+		returnStatement, // return c.panicValue
+	)
+}
+
+func generateMockFuncCallFaultMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.faultInjection {
+		return jen.Null()
+	}
+
+	commentText := strings.Join([]string{
+		`Fault returns the latency and error injected into this invocation via InjectLatency and InjectError.`,
+		`An invocation with an injected error was not handled by any hook.`,
+	}, " ")
+
+	returnStatement := jen.Return(jen.Id("c").Dot("fault"))
+
+	results := []jen.Code{jen.Qual(supportImportPath, "Fault")}
+	return generateMockFuncCallMethod(iface, outputImportPath, method, "Fault", commentText, nil, results,
+		returnStatement, // return c.fault
+	)
+}
+
+func generateMockFuncCallTimestampMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.recordCallMetadata {
+		return jen.Null()
+	}
+
+	commentText := `Timestamp returns the time at which this invocation began.`
+	returnStatement := jen.Return(jen.Id("c").Dot("metadata").Dot("Timestamp"))
+
+	results := []jen.Code{jen.Qual("time", "Time")}
+	return generateMockFuncCallMethod(iface, outputImportPath, method, "Timestamp", commentText, nil, results,
+		returnStatement, // return c.metadata.Timestamp
+	)
+}
+
+func generateMockFuncCallGoroutineIDMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.recordCallMetadata {
+		return jen.Null()
+	}
+
+	commentText := `GoroutineID returns the identifier of the goroutine that made this invocation.`
+	returnStatement := jen.Return(jen.Id("c").Dot("metadata").Dot("GoroutineID"))
+
+	results := []jen.Code{jen.Uint64()}
+	return generateMockFuncCallMethod(iface, outputImportPath, method, "GoroutineID", commentText, nil, results,
+		returnStatement, // return c.metadata.GoroutineID
+	)
+}
+
+func generateMockFuncCallCallerMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.recordCallMetadata {
+		return jen.Null()
+	}
+
+	commentText := `Caller returns the file:line location of the code that made this invocation.`
+	returnStatement := jen.Return(jen.Id("c").Dot("metadata").Dot("Caller"))
+
+	results := []jen.Code{jen.String()}
+	return generateMockFuncCallMethod(iface, outputImportPath, method, "Caller", commentText, nil, results,
+		returnStatement, // return c.metadata.Caller
+	)
+}
+
 func generateMockFuncCallMethod(
 	iface *wrappedInterface,
 	outputImportPath string,
@@ -65,3 +65,112 @@ func TestGenerateMockFuncCallResultsMethodMultiple(t *testing.T) {
 	`)
 	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
 }
+
+func TestGenerateMockFuncCallSequenceMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.callSequence = true
+	code := generateMockFuncCallSequenceMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Sequence returns the process-wide position of this invocation among the
+		// invocations of all mock functions. The sequence number is taken when the
+		// invocation begins, so comparing the sequence numbers of two invocations
+		// determines which began first, even across different mock instances and
+		// when one invocation begins and ends within the hook of another.
+		func (c TestClientDoFuncCall) Sequence() uint64 {
+			return c.sequence
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncCallTimestampMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.recordCallMetadata = true
+	code := generateMockFuncCallTimestampMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Timestamp returns the time at which this invocation began.
+		func (c TestClientDoFuncCall) Timestamp() time.Time {
+			return c.metadata.Timestamp
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncCallGoroutineIDMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.recordCallMetadata = true
+	code := generateMockFuncCallGoroutineIDMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// GoroutineID returns the identifier of the goroutine that made this
+		// invocation.
+		func (c TestClientDoFuncCall) GoroutineID() uint64 {
+			return c.metadata.GoroutineID
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncCallCallerMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.recordCallMetadata = true
+	code := generateMockFuncCallCallerMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Caller returns the file:line location of the code that made this
+		// invocation.
+		func (c TestClientDoFuncCall) Caller() string {
+			return c.metadata.Caller
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncCallArgsMethodParameterNames(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodGet)
+	wrappedMethod.useParameterNames(nil)
+	code := generateMockFuncCallArgsMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Args returns an interface slice containing the arguments of this
+		// invocation.
+		func (c TestClientGetFuncCall) Args() []interface{} {
+			return []interface{}{c.Key, c.Arg1, c.M, c.Arg3}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncCallPanicValueMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.recordPanics = true
+	code := generateMockFuncCallPanicValueMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// PanicValue returns the value with which the hook handling this invocation
+		// panicked, or nil if the hook returned normally. The results of an
+		// invocation whose hook panicked are zero values.
+		func (c TestClientDoFuncCall) PanicValue() interface{} {
+			return c.panicValue
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncCallFaultMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.faultInjection = true
+	code := generateMockFuncCallFaultMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Fault returns the latency and error injected into this invocation via
+		// InjectLatency and InjectError. An invocation with an injected error was
+		// not handled by any hook.
+		func (c TestClientDoFuncCall) Fault() mocksupport.Fault {
+			return c.fault
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncCallOptInMethodsDisabled(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncCallFaultMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncCallSequenceMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncCallPanicValueMethod(wrappedInterface, wrappedMethod, "")))
+}
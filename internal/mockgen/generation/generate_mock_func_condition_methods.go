@@ -0,0 +1,68 @@
+package generation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+func generateMockFuncConditionHookMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	commentText := strings.Join([]string{
+		fmt.Sprintf(`Hook registers this condition with a function that is called when the %s method of the parent %s instance is invoked with matching arguments.`, method.Name, iface.mockStructName),
+		`Registered conditions are checked in order before the hook queue.`,
+		`If no condition matches, the hook queue and then the default hook function are used.`,
+	}, " ")
+
+	lockStatement := jen.Id("c").Dot("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("c").Dot("f").Dot("mutex").Dot("Unlock").Call()
+	assignStatement := jen.Id("c").Dot("hook").Op("=").Id("hook")
+	appendStatement := selfAppend(jen.Id("c").Dot("f").Dot("conditions"), jen.Id("c"))
+
+	params := []jen.Code{compose(jen.Id("hook"), method.signature)}
+	return generateMockFuncConditionMethod(iface, outputImportPath, method, "Hook", commentText, params, nil,
+		lockStatement,   // c.f.mutex.Lock()
+		assignStatement, // c.hook = hook
+		appendStatement, // c.f.conditions = append(c.f.conditions, c)
+		unlockStatement, // c.f.mutex.Unlock()
+	)
+}
+
+func generateMockFuncConditionReturnMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	commentText := `Return calls Hook with a function that returns the given values.`
+
+	names := make([]jen.Code, 0, len(method.resultTypes))
+	params := make([]jen.Code, 0, len(method.resultTypes))
+	for i, typ := range method.resultTypes {
+		name := jen.Id(fmt.Sprintf("r%d", i))
+		names = append(names, name)
+		params = append(params, compose(name, typ))
+	}
+
+	returnStatement := jen.Return().List(names...)
+	functionExpression := jen.Func().Params(method.paramTypes...).Params(method.resultTypes...).Block(returnStatement)
+	callStatement := jen.Id("c").Dot("Hook").Call(functionExpression)
+
+	return generateMockFuncConditionMethod(iface, outputImportPath, method, "Return", commentText, params, nil,
+		callStatement, // c.Hook(func( T<n>, ... ) { return r<n>, ... })
+	)
+}
+
+func generateMockFuncConditionMethod(
+	iface *wrappedInterface,
+	outputImportPath string,
+	method *wrappedMethod,
+	methodName string,
+	commentText string,
+	params, results []jen.Code,
+	body ...jen.Code,
+) jen.Code {
+	if !iface.conditions {
+		return jen.Null()
+	}
+
+	mockFuncConditionStructName := fmt.Sprintf("%s%s%sFuncCondition", iface.prefix, iface.titleName, method.Name)
+	receiver := compose(jen.Id("c").Op("*"), addTypes(jen.Id(mockFuncConditionStructName), iface.TypeParams, outputImportPath, false))
+	methodDeclaration := jen.Func().Params(receiver).Id(methodName).Params(params...).Params(results...).Block(body...)
+	return addComment(methodDeclaration, 1, commentText)
+}
@@ -0,0 +1,43 @@
+package generation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateMockFuncConditionHookMethod(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.conditions = true
+	code := generateMockFuncConditionHookMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		// Hook registers this condition with a function that is called when the Do
+		// method of the parent MockTestClient instance is invoked with matching
+		// arguments. Registered conditions are checked in order before the hook
+		// queue. If no condition matches, the hook queue and then the default hook
+		// function are used.
+		func (c *TestClientDoFuncCondition) Hook(hook func(string) bool) {
+			c.f.mutex.Lock()
+			c.hook = hook
+			c.f.conditions = append(c.f.conditions, c)
+			c.f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncConditionReturnMethod(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.conditions = true
+	code := generateMockFuncConditionReturnMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		// Return calls Hook with a function that returns the given values.
+		func (c *TestClientDoFuncCondition) Return(r0 bool) {
+			c.Hook(func(string) bool {
+				return r0
+			})
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
@@ -0,0 +1,85 @@
+package generation
+
+import (
+	"fmt"
+
+	"github.com/dave/jennifer/jen"
+)
+
+func generateMockFuncExpectationTimesMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	mockFuncExpectationStructName := fmt.Sprintf("%s%s%sFuncExpectation", iface.prefix, iface.titleName, method.Name)
+	commentText := `Times sets the number of invocations that satisfy this expectation.`
+
+	lockStatement := jen.Id("e").Dot("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("e").Dot("f").Dot("mutex").Dot("Unlock").Call()
+	assignStatement := jen.Id("e").Dot("times").Op("=").Id("n")
+	returnStatement := jen.Return(jen.Id("e"))
+
+	params := []jen.Code{jen.Id("n").Int()}
+	results := []jen.Code{compose(jen.Op("*"), addTypes(jen.Id(mockFuncExpectationStructName), iface.TypeParams, outputImportPath, false))}
+	return generateMockFuncExpectationMethod(iface, outputImportPath, method, "Times", commentText, params, results,
+		lockStatement,   // e.f.mutex.Lock()
+		assignStatement, // e.times = n
+		unlockStatement, // e.f.mutex.Unlock()
+		jen.Line(),
+		returnStatement, // return e
+	)
+}
+
+func generateMockFuncExpectationHookMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	commentText := fmt.Sprintf(
+		`Hook sets a function that is called when the %s method of the parent %s instance is invoked with arguments matching this expectation.`,
+		method.Name,
+		iface.mockStructName,
+	)
+
+	lockStatement := jen.Id("e").Dot("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("e").Dot("f").Dot("mutex").Dot("Unlock").Call()
+	assignStatement := jen.Id("e").Dot("hook").Op("=").Id("hook")
+
+	params := []jen.Code{compose(jen.Id("hook"), method.signature)}
+	return generateMockFuncExpectationMethod(iface, outputImportPath, method, "Hook", commentText, params, nil,
+		lockStatement,   // e.f.mutex.Lock()
+		assignStatement, // e.hook = hook
+		unlockStatement, // e.f.mutex.Unlock()
+	)
+}
+
+func generateMockFuncExpectationReturnMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	commentText := `Return calls Hook with a function that returns the given values.`
+
+	names := make([]jen.Code, 0, len(method.resultTypes))
+	params := make([]jen.Code, 0, len(method.resultTypes))
+	for i, typ := range method.resultTypes {
+		name := jen.Id(fmt.Sprintf("r%d", i))
+		names = append(names, name)
+		params = append(params, compose(name, typ))
+	}
+
+	returnStatement := jen.Return().List(names...)
+	functionExpression := jen.Func().Params(method.paramTypes...).Params(method.resultTypes...).Block(returnStatement)
+	callStatement := jen.Id("e").Dot("Hook").Call(functionExpression)
+
+	return generateMockFuncExpectationMethod(iface, outputImportPath, method, "Return", commentText, params, nil,
+		callStatement, // e.Hook(func( T<n>, ... ) { return r<n>, ... })
+	)
+}
+
+func generateMockFuncExpectationMethod(
+	iface *wrappedInterface,
+	outputImportPath string,
+	method *wrappedMethod,
+	methodName string,
+	commentText string,
+	params, results []jen.Code,
+	body ...jen.Code,
+) jen.Code {
+	if !iface.expectations {
+		return jen.Null()
+	}
+
+	mockFuncExpectationStructName := fmt.Sprintf("%s%s%sFuncExpectation", iface.prefix, iface.titleName, method.Name)
+	receiver := compose(jen.Id("e").Op("*"), addTypes(jen.Id(mockFuncExpectationStructName), iface.TypeParams, outputImportPath, false))
+	methodDeclaration := jen.Func().Params(receiver).Id(methodName).Params(params...).Params(results...).Block(body...)
+	return addComment(methodDeclaration, 1, commentText)
+}
@@ -0,0 +1,64 @@
+package generation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateMockFuncExpectationTimesMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.expectations = true
+	code := generateMockFuncExpectationTimesMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Times sets the number of invocations that satisfy this expectation.
+		func (e *TestClientDoFuncExpectation) Times(n int) *TestClientDoFuncExpectation {
+			e.f.mutex.Lock()
+			e.times = n
+			e.f.mutex.Unlock()
+
+			return e
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncExpectationHookMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.expectations = true
+	code := generateMockFuncExpectationHookMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Hook sets a function that is called when the Do method of the parent
+		// MockTestClient instance is invoked with arguments matching this
+		// expectation.
+		func (e *TestClientDoFuncExpectation) Hook(hook func(string) bool) {
+			e.f.mutex.Lock()
+			e.hook = hook
+			e.f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncExpectationReturnMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.expectations = true
+	code := generateMockFuncExpectationReturnMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Return calls Hook with a function that returns the given values.
+		func (e *TestClientDoFuncExpectation) Return(r0 bool) {
+			e.Hook(func(string) bool {
+				return r0
+			})
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncExpectationMethodsDisabled(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncExpectationTimesMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncExpectationHookMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncExpectationReturnMethod(wrappedInterface, wrappedMethod, "")))
+}
@@ -0,0 +1,98 @@
+package generation
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dave/jennifer/jen"
+)
+
+func generateMockFuncGateEnteredMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.blockingHooks {
+		return jen.Null()
+	}
+
+	commentText := strings.Join([]string{
+		`Entered returns a channel that is closed once an invocation begins blocking on this gate.`,
+		`Tests can receive from this channel to wait until the code under test is paused inside the invocation.`,
+	}, " ")
+
+	returnStatement := jen.Return(jen.Id("g").Dot("entered"))
+
+	results := []jen.Code{jen.Op("<-").Chan().Struct()}
+	return generateMockFuncGateMethod(iface, outputImportPath, method, "Entered", commentText, nil, results,
+		returnStatement, // return g.entered
+	)
+}
+
+func generateMockFuncGateReleaseMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.blockingHooks {
+		return jen.Null()
+	}
+
+	mockFuncResultsStructName := fmt.Sprintf("%s%s%sResults", iface.prefix, iface.titleName, method.Name)
+	commentText := strings.Join([]string{
+		`Release unblocks the invocation blocked on this gate, which then returns the given values.`,
+		`Release may be called before the invocation begins, in which case the invocation does not block.`,
+		`A gate can be released or failed only once.`,
+	}, " ")
+
+	params := make([]jen.Code, 0, len(method.resultTypes))
+	fields := make([]jen.Code, 0, len(method.resultTypes))
+	for i, typ := range method.resultTypes {
+		name := jen.Id(fmt.Sprintf("r%d", i))
+		params = append(params, compose(name, typ))
+		fields = append(fields, jen.Id(fmt.Sprintf("Result%d", i)).Op(":").Add(name))
+	}
+
+	resultsExpression := compose(addTypes(jen.Id(mockFuncResultsStructName), iface.TypeParams, outputImportPath, false), jen.Values(fields...))
+	return generateMockFuncGateMethod(iface, outputImportPath, method, "Release", commentText, params, nil,
+		generateGateSendStatement(iface, method, resultsExpression), // if g.released.Swap(true) { panic(...) }; g.results <- <prefix>Results{Result<n>: r<n>, ...}
+	)
+}
+
+func generateMockFuncGateFailMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.blockingHooks || !method.returnsError() {
+		return jen.Null()
+	}
+
+	mockFuncResultsStructName := fmt.Sprintf("%s%s%sResults", iface.prefix, iface.titleName, method.Name)
+	commentText := strings.Join([]string{
+		`Fail unblocks the invocation blocked on this gate, which then returns the given error and zero values for all other results.`,
+		`A gate can be released or failed only once.`,
+	}, " ")
+
+	lastField := jen.Id(fmt.Sprintf("Result%d", len(method.resultTypes)-1)).Op(":").Id("err")
+	resultsExpression := compose(addTypes(jen.Id(mockFuncResultsStructName), iface.TypeParams, outputImportPath, false), jen.Values(lastField))
+
+	params := []jen.Code{jen.Id("err").Error()}
+	return generateMockFuncGateMethod(iface, outputImportPath, method, "Fail", commentText, params, nil,
+		generateGateSendStatement(iface, method, resultsExpression), // if g.released.Swap(true) { panic(...) }; g.results <- <prefix>Results{Result<n>: err}
+	)
+}
+
+func generateGateSendStatement(iface *wrappedInterface, method *wrappedMethod, resultsExpression jen.Code) jen.Code {
+	// if g.released.Swap(true) { panic("<Struct>.<Method> gate was already released") }
+	releasedCondition := jen.Id("g").Dot("released").Dot("Swap").Call(jen.True())
+	panicStatement := jen.Panic(jen.Lit(fmt.Sprintf("%s.%s gate was already released", iface.mockStructName, method.Name)))
+	checkStatement := jen.If(releasedCondition).Block(panicStatement)
+
+	// g.results <- <results>
+	sendStatement := jen.Id("g").Dot("results").Op("<-").Add(resultsExpression)
+	return compose(checkStatement, jen.Line(), sendStatement)
+}
+
+func generateMockFuncGateMethod(
+	iface *wrappedInterface,
+	outputImportPath string,
+	method *wrappedMethod,
+	methodName string,
+	commentText string,
+	params, results []jen.Code,
+	body ...jen.Code,
+) jen.Code {
+	mockFuncGateStructName := fmt.Sprintf("%s%s%sFuncGate", iface.prefix, iface.titleName, method.Name)
+	receiver := compose(jen.Id("g").Op("*"), addTypes(jen.Id(mockFuncGateStructName), iface.TypeParams, outputImportPath, false))
+	methodDeclaration := jen.Func().Params(receiver).Id(methodName).Params(params...).Params(results...).Block(body...)
+	return addComment(methodDeclaration, 1, commentText)
+}
@@ -0,0 +1,73 @@
+package generation
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateMockFuncGateEnteredMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.blockingHooks = true
+	code := generateMockFuncGateEnteredMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Entered returns a channel that is closed once an invocation begins
+		// blocking on this gate. Tests can receive from this channel to wait until
+		// the code under test is paused inside the invocation.
+		func (g *TestClientDoFuncGate) Entered() <-chan struct{} {
+			return g.entered
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncGateReleaseMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	wrappedInterface.blockingHooks = true
+	code := generateMockFuncGateReleaseMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Release unblocks the invocation blocked on this gate, which then returns
+		// the given values. Release may be called before the invocation begins, in
+		// which case the invocation does not block. A gate can be released or
+		// failed only once.
+		func (g *TestClientFetchFuncGate) Release(r0 string, r1 error) {
+			if g.released.Swap(true) {
+				panic("MockTestClient.Fetch gate was already released")
+			}
+			g.results <- TestClientFetchResults{Result0: r0, Result1: r1}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncGateFailMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	wrappedInterface.blockingHooks = true
+	code := generateMockFuncGateFailMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Fail unblocks the invocation blocked on this gate, which then returns the
+		// given error and zero values for all other results. A gate can be released
+		// or failed only once.
+		func (g *TestClientFetchFuncGate) Fail(err error) {
+			if g.released.Swap(true) {
+				panic("MockTestClient.Fetch gate was already released")
+			}
+			g.results <- TestClientFetchResults{Result1: err}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncGateFailMethodWithoutErrorResult(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.blockingHooks = true
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncGateFailMethod(wrappedInterface, wrappedMethod, "")))
+}
+
+func TestGenerateMockFuncGateMethodsDisabled(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncGateEnteredMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncGateReleaseMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncGateFailMethod(wrappedInterface, wrappedMethod, "")))
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/dave/jennifer/jen"
+	"github.com/dustin/go-humanize"
 )
 
 func generateMockFuncSetHookMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
@@ -15,10 +16,42 @@ func generateMockFuncSetHookMethod(iface *wrappedInterface, method *wrappedMetho
 	)
 
 	assignStatement := jen.Id("f").Dot("defaultHook").Op("=").Id("hook")
+	var clearCallHookStatement jen.Code = jen.Null()
+	if iface.callInfoHooks {
+		clearCallHookStatement = jen.Id("f").Dot("defaultCallHook").Op("=").Nil()
+	}
 
 	params := []jen.Code{compose(jen.Id("hook"), method.signature)}
+	if !iface.resetMethods && !iface.callInfoHooks {
+		return generateMockFuncMethod(iface, outputImportPath, method, "SetDefaultHook", commentText, params, nil,
+			assignStatement, // f.defaultHook = hook
+		)
+	}
+
+	// The initial hook is read by Reset and the default call hook is read by nextHook,
+	// either of which may run concurrently
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
+
 	return generateMockFuncMethod(iface, outputImportPath, method, "SetDefaultHook", commentText, params, nil,
-		assignStatement, // f.defaultHook = hook
+		lockStatement,                           // f.mutex.Lock()
+		generateSaveInitialHookStatement(iface), // if !f.initialHookSaved { f.initialHook = f.defaultHook; f.initialHookSaved = true } (if enabled)
+		assignStatement,                         // f.defaultHook = hook
+		clearCallHookStatement,                  // f.defaultCallHook = nil (if enabled)
+		unlockStatement,                         // f.mutex.Unlock()
+	)
+}
+
+// generateSaveInitialHookStatement saves the default hook set by the constructor so
+// that it can be restored by Reset the first time the default hook is replaced.
+func generateSaveInitialHookStatement(iface *wrappedInterface) jen.Code {
+	if !iface.resetMethods {
+		return jen.Null()
+	}
+
+	return jen.If(jen.Op("!").Id("f").Dot("initialHookSaved")).Block(
+		jen.Id("f").Dot("initialHook").Op("=").Id("f").Dot("defaultHook"),
+		jen.Id("f").Dot("initialHookSaved").Op("=").True(),
 	)
 }
 
@@ -36,8 +69,120 @@ func generateMockFuncPushHookMethod(iface *wrappedInterface, method *wrappedMeth
 	params := []jen.Code{compose(jen.Id("hook"), method.signature)}
 	return generateMockFuncMethod(iface, outputImportPath, method, "PushHook", commentText, params, nil,
 		lockStatement,   // f.mutex.Lock()
-		appendStatement, // f.mutex.Unlock()
-		unlockStatement, // f.hooks = append(f.hooks, hook)
+		appendStatement, // f.hooks = append(f.hooks, hook)
+		unlockStatement, // f.mutex.Unlock()
+	)
+}
+
+func generateMockFuncPushHookNMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.returnSequences {
+		return jen.Null()
+	}
+
+	commentText := `PushHookN adds the given function to the end of the hook queue n times.`
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
+	appendStatement := selfAppend(jen.Id("f").Dot("hooks"), jen.Id("hook"))
+	loopStatement := jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("n"), jen.Id("i").Op("++")).Block(appendStatement)
+
+	params := []jen.Code{jen.Id("n").Int(), compose(jen.Id("hook"), method.signature)}
+	return generateMockFuncMethod(iface, outputImportPath, method, "PushHookN", commentText, params, nil,
+		lockStatement,   // f.mutex.Lock()
+		loopStatement,   // for i := 0; i < n; i++ { f.hooks = append(f.hooks, hook) }
+		unlockStatement, // f.mutex.Unlock()
+	)
+}
+
+func generateMockFuncSetHookWithCallMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.callInfoHooks {
+		return jen.Null()
+	}
+
+	callInfoStructName := fmt.Sprintf("%s%sCallInfo", iface.prefix, iface.titleName)
+	commentText := fmt.Sprintf(
+		`SetDefaultHookWithCall is like SetDefaultHook, but the given function also receives a %s describing the invocation.`,
+		callInfoStructName,
+	)
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
+	assignStatement := jen.Id("f").Dot("defaultCallHook").Op("=").Id("hook")
+
+	params := []jen.Code{compose(jen.Id("hook"), callHookSignature(iface, method, outputImportPath))}
+	return generateMockFuncMethod(iface, outputImportPath, method, "SetDefaultHookWithCall", commentText, params, nil,
+		lockStatement,                           // f.mutex.Lock()
+		generateSaveInitialHookStatement(iface), // if !f.initialHookSaved { f.initialHook = f.defaultHook; f.initialHookSaved = true } (if enabled)
+		assignStatement,                         // f.defaultCallHook = hook
+		unlockStatement,                         // f.mutex.Unlock()
+	)
+}
+
+func generateMockFuncPushHookWithCallMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.callInfoHooks {
+		return jen.Null()
+	}
+
+	callInfoStructName := fmt.Sprintf("%s%sCallInfo", iface.prefix, iface.titleName)
+	commentText := fmt.Sprintf(
+		`PushHookWithCall is like PushHook, but the given function also receives a %s describing the invocation.`,
+		callInfoStructName,
+	)
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
+	appendPlaceholderStatement := selfAppend(jen.Id("f").Dot("hooks"), jen.Nil())
+	appendStatement := selfAppend(jen.Id("f").Dot("callHooks"), jen.Id("hook"))
+
+	params := []jen.Code{compose(jen.Id("hook"), callHookSignature(iface, method, outputImportPath))}
+	return generateMockFuncMethod(iface, outputImportPath, method, "PushHookWithCall", commentText, params, nil,
+		lockStatement,              // f.mutex.Lock()
+		appendPlaceholderStatement, // f.hooks = append(f.hooks, nil)
+		appendStatement,            // f.callHooks = append(f.callHooks, hook)
+		unlockStatement,            // f.mutex.Unlock()
+	)
+}
+
+func generateMockFuncPushBlockingHookMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.blockingHooks {
+		return jen.Null()
+	}
+
+	mockFuncGateStructName := fmt.Sprintf("%s%s%sFuncGate", iface.prefix, iface.titleName, method.Name)
+	mockFuncResultsStructName := fmt.Sprintf("%s%s%sResults", iface.prefix, iface.titleName, method.Name)
+	gateType := addTypes(jen.Id(mockFuncGateStructName), iface.TypeParams, outputImportPath, false)
+	resultsType := addTypes(jen.Id(mockFuncResultsStructName), iface.TypeParams, outputImportPath, false)
+	commentText := strings.Join([]string{
+		`PushBlockingHook adds a hook to the end of the hook queue that blocks the invocation handling it until the returned gate is released or failed.`,
+		`The gate reports when the invocation begins blocking, which allows a test to inspect intermediate state while the code under test is paused inside the invocation.`,
+	}, " ")
+
+	gateDeclaration := jen.Id("gate").Op(":=").Add(compose(jen.Op("&"), gateType)).Values(
+		jen.Id("entered").Op(":").Make(jen.Chan().Struct()),
+		jen.Id("results").Op(":").Make(compose(jen.Chan(), resultsType), jen.Lit(1)),
+	)
+
+	values := make([]jen.Code, 0, len(method.resultTypes))
+	for i := range method.resultTypes {
+		values = append(values, jen.Id("r").Dot(fmt.Sprintf("Result%d", i)))
+	}
+
+	closeStatement := jen.Close(jen.Id("gate").Dot("entered"))
+	receiveStatement := jen.Id("r").Op(":=").Op("<-").Id("gate").Dot("results")
+	returnStatement := jen.Return().List(values...)
+	if len(method.resultTypes) == 0 {
+		// The hooks of methods without results discard the received value
+		receiveStatement = jen.Op("<-").Id("gate").Dot("results")
+		returnStatement = jen.Null()
+	}
+	functionExpression := jen.Func().Params(method.paramTypes...).Params(method.resultTypes...).Block(closeStatement, receiveStatement, returnStatement)
+	pushStatement := jen.Id("f").Dot("PushHook").Call(functionExpression)
+
+	results := []jen.Code{compose(jen.Op("*"), gateType)}
+	return generateMockFuncMethod(iface, outputImportPath, method, "PushBlockingHook", commentText, nil, results,
+		gateDeclaration,            // gate := &<prefix>FuncGate{entered: make(chan struct{}), results: make(chan <prefix>Results, 1)}
+		pushStatement,              // f.PushHook(func( T<n>, ... ) { close(gate.entered); r := <-gate.results; return r.Result<n>, ... })
+		jen.Return(jen.Id("gate")), // return gate
 	)
 }
 
@@ -73,24 +218,638 @@ func generateMockReturnMethod(iface *wrappedInterface, method *wrappedMethod, me
 	)
 }
 
+func generateMockFuncPushReturnNMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.returnSequences {
+		return jen.Null()
+	}
+
+	commentText := `PushReturnN calls PushReturn with the given values n times.`
+
+	names := make([]jen.Code, 0, len(method.resultTypes))
+	params := make([]jen.Code, 0, len(method.resultTypes)+1)
+	params = append(params, jen.Id("n").Int())
+	for i, typ := range method.resultTypes {
+		name := jen.Id(fmt.Sprintf("r%d", i))
+		names = append(names, name)
+		params = append(params, compose(name, typ))
+	}
+
+	pushStatement := jen.Id("f").Dot("PushReturn").Call(names...)
+	loopStatement := jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("n"), jen.Id("i").Op("++")).Block(pushStatement)
+
+	return generateMockFuncMethod(iface, outputImportPath, method, "PushReturnN", commentText, params, nil,
+		loopStatement, // for i := 0; i < n; i++ { f.PushReturn(r<n>, ...) }
+	)
+}
+
+func generateMockFuncSetReturnSequenceMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.returnSequences {
+		return jen.Null()
+	}
+
+	mockFuncResultsStructName := fmt.Sprintf("%s%s%sResults", iface.prefix, iface.titleName, method.Name)
+	resultsType := addTypes(jen.Id(mockFuncResultsStructName), iface.TypeParams, outputImportPath, false)
+	commentText := strings.Join([]string{
+		`SetReturnSequence replaces the hook queue with one hook for each of the given results, in order.`,
+		`If repeatLast is true, the final results are also returned by every invocation after the queue is empty.`,
+		`Otherwise, the default hook function is invoked once the sequence is exhausted.`,
+	}, " ")
+
+	values := make([]jen.Code, 0, len(method.resultTypes))
+	for i := range method.resultTypes {
+		values = append(values, jen.Id("r").Dot(fmt.Sprintf("Result%d", i)))
+	}
+
+	hooksDeclaration := jen.Id("hooks").Op(":=").Make(compose(jen.Index(), method.signature), jen.Lit(0), jen.Len(jen.Id("results")))
+	functionExpression := jen.Func().Params(method.paramTypes...).Params(method.resultTypes...).Block(jen.Return().List(values...))
+	hooksLoop := jen.For(jen.Id("_").Op(",").Id("r").Op(":=").Range().Id("results")).Block(
+		jen.Id("r").Op(":=").Id("r"),
+		selfAppend(jen.Id("hooks"), functionExpression),
+	)
+	if len(method.resultTypes) == 0 {
+		// The hooks of methods without results do not refer to the loop variable
+		hooksLoop = jen.For(jen.Range().Id("results")).Block(selfAppend(jen.Id("hooks"), functionExpression))
+	}
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
+	assignStatement := jen.Id("f").Dot("hooks").Op("=").Id("hooks")
+	clearCallHooksStatement := jen.Id("f").Dot("callHooks").Op("=").Nil()
+	repeatCondition := jen.Id("repeatLast").Op("&&").Len(jen.Id("hooks")).Op(">").Lit(0)
+	repeatStatement := jen.If(repeatCondition).Block(
+		jen.Id("f").Dot("SetDefaultHook").Call(jen.Id("hooks").Index(jen.Len(jen.Id("hooks")).Op("-").Lit(1))),
+	)
+
+	params := []jen.Code{jen.Id("repeatLast").Bool(), compose(jen.Id("results").Op("..."), resultsType)}
+	return generateMockFuncMethod(iface, outputImportPath, method, "SetReturnSequence", commentText, params, nil,
+		hooksDeclaration,      // hooks := make([]<signature>, 0, len(results))
+		hooksLoop, jen.Line(), // for _, r := range results { r := r; hooks = append(hooks, func( T<n>, ... ) { return r.Result<n>, ... }) }
+		lockStatement,               // f.mutex.Lock()
+		assignStatement,             // f.hooks = hooks
+		clearCallHooksStatement,     // f.callHooks = nil
+		unlockStatement, jen.Line(), // f.mutex.Unlock()
+		repeatStatement, // if repeatLast && len(hooks) > 0 { f.SetDefaultHook(hooks[len(hooks)-1]) }
+	)
+}
+
+func generateMockFuncSetErrorMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	return generateMockErrorMethod(iface, method, "SetDefault", outputImportPath)
+}
+
+func generateMockFuncPushErrorMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	return generateMockErrorMethod(iface, method, "Push", outputImportPath)
+}
+
+func generateMockErrorMethod(iface *wrappedInterface, method *wrappedMethod, methodPrefix, outputImportPath string) jen.Code {
+	if !iface.errorHelpers || !method.returnsError() {
+		return jen.Null()
+	}
+
+	commentText := fmt.Sprintf(
+		`%sError calls %sHook with a function that returns the given error and zero values for all other results.`,
+		methodPrefix,
+		methodPrefix,
+	)
+
+	lastIndex := len(method.resultTypes) - 1
+	results := make([]jen.Code, 0, len(method.resultTypes))
+	values := make([]jen.Code, 0, len(method.resultTypes))
+	for i, typ := range method.resultTypes {
+		name := jen.Id(fmt.Sprintf("r%d", i))
+		results = append(results, compose(name, typ))
+
+		if i == lastIndex {
+			values = append(values, jen.Id("err"))
+		} else {
+			values = append(values, name)
+		}
+	}
+
+	returnStatement := jen.Return().List(values...)
+	functionExpression := jen.Func().Params(method.paramTypes...).Params(results...).Block(returnStatement)
+	callStatement := jen.Id("f").Dot(fmt.Sprintf("%sHook", methodPrefix)).Call(functionExpression)
+
+	params := []jen.Code{jen.Id("err").Error()}
+	return generateMockFuncMethod(iface, outputImportPath, method, fmt.Sprintf("%sError", methodPrefix), commentText, params, nil,
+		callStatement, // f.<SetDefault|Push>Hook(func( T<n>, ... ) (r<n> R<n>, ...) { return r<n>, ..., err })
+	)
+}
+
+func generateMockFuncPushErrorNMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.errorHelpers || !method.returnsError() {
+		return jen.Null()
+	}
+
+	commentText := `PushErrorN calls PushError with the given error n times.`
+
+	pushStatement := jen.Id("f").Dot("PushError").Call(jen.Id("err"))
+	loopStatement := jen.For(jen.Id("i").Op(":=").Lit(0), jen.Id("i").Op("<").Id("n"), jen.Id("i").Op("++")).Block(pushStatement)
+
+	params := []jen.Code{jen.Id("n").Int(), jen.Id("err").Error()}
+	return generateMockFuncMethod(iface, outputImportPath, method, "PushErrorN", commentText, params, nil,
+		loopStatement, // for i := 0; i < n; i++ { f.PushError(err) }
+	)
+}
+
+func generateMockFuncInjectErrorMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.injectsErrors(method) {
+		return jen.Null()
+	}
+
+	commentText := []string{
+		`InjectError causes the given fraction of subsequent invocations to return the given error and zero values for all other results without invoking any hook.`,
+		`Injected errors are drawn from a source seeded via SetFaultSeed and are recorded in the invocation history.`,
+	}
+	if iface.expectations {
+		commentText = append(commentText, `An invocation answered by an injected error is still matched against and counted by expectations, but never invokes the expectation's hook.`)
+	}
+	commentText = append(commentText, `A rate of zero or a nil error disables error injection.`)
+
+	setStatement := jen.Id("f").Dot("faults").Dot("SetError").Call(jen.Id("rate"), jen.Id("err"))
+
+	params := []jen.Code{jen.Id("rate").Float64(), jen.Id("err").Error()}
+	return generateMockFuncMethod(iface, outputImportPath, method, "InjectError", strings.Join(commentText, " "), params, nil,
+		setStatement, // f.faults.SetError(rate, err)
+	)
+}
+
+func generateMockFuncInjectLatencyMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.faultInjection {
+		return jen.Null()
+	}
+
+	commentText := strings.Join([]string{
+		`InjectLatency delays each subsequent invocation by the given duration before it is handled.`,
+		`The injected latency is recorded in the invocation history.`,
+	}, " ")
+
+	setStatement := jen.Id("f").Dot("faults").Dot("SetLatency").Call(jen.Id("d"))
+
+	params := []jen.Code{jen.Id("d").Qual("time", "Duration")}
+	return generateMockFuncMethod(iface, outputImportPath, method, "InjectLatency", commentText, params, nil,
+		setStatement, // f.faults.SetLatency(d)
+	)
+}
+
+func generateMockFuncSetFaultSeedMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.faultInjection {
+		return jen.Null()
+	}
+
+	commentText := `SetFaultSeed seeds the source from which injected faults are drawn. The source is seeded with zero by default.`
+
+	seedStatement := jen.Id("f").Dot("faults").Dot("Seed").Call(jen.Id("seed"))
+
+	params := []jen.Code{jen.Id("seed").Int64()}
+	return generateMockFuncMethod(iface, outputImportPath, method, "SetFaultSeed", commentText, params, nil,
+		seedStatement, // f.faults.Seed(seed)
+	)
+}
+
+func generateMockFuncWhenMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.conditions {
+		return jen.Null()
+	}
+
+	mockFuncConditionStructName := fmt.Sprintf("%s%s%sFuncCondition", iface.prefix, iface.titleName, method.Name)
+	commentText := strings.Join([]string{
+		fmt.Sprintf(`When returns a condition matching invocations of the %s method of the parent %s instance with arguments equal to the given values.`, method.Name, iface.mockStructName),
+		`Arguments are compared via reflect.DeepEqual, except that byte slices are compared by content.`,
+		`Use WhenFunc to match arguments by other criteria.`,
+		`The condition takes effect once a hook is registered on it.`,
+	}, " ")
+
+	params := make([]jen.Code, 0, len(method.paramTypes))
+	for i, param := range method.paramTypes {
+		params = append(params, compose(jen.Id(fmt.Sprintf("v%d", i)), param))
+	}
+
+	// return f.WhenFunc(func(a<n> T<n>, ...) bool { return mocksupport.ValuesEqual(v<n>, a<n>) && ... })
+	returnStatement := jen.Return(jen.Id("f").Dot("WhenFunc").Call(generateEqualityMatcher(method)))
+
+	results := []jen.Code{compose(jen.Op("*"), addTypes(jen.Id(mockFuncConditionStructName), iface.TypeParams, outputImportPath, false))}
+	return generateMockFuncMethod(iface, outputImportPath, method, "When", commentText, params, results,
+		returnStatement, // return f.WhenFunc(func(a<n> T<n>, ...) bool { ... })
+	)
+}
+
+func generateMockFuncWhenFuncMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.conditions {
+		return jen.Null()
+	}
+
+	mockFuncConditionStructName := fmt.Sprintf("%s%s%sFuncCondition", iface.prefix, iface.titleName, method.Name)
+	commentText := strings.Join([]string{
+		fmt.Sprintf(`WhenFunc returns a condition matching invocations of the %s method of the parent %s instance for which the given function returns true.`, method.Name, iface.mockStructName),
+		`The function is invoked with the arguments of each invocation without holding the lock of this mock function, so it may inspect or invoke the mock.`,
+		`The condition takes effect once a hook is registered on it.`,
+	}, " ")
+
+	conditionExpression := generateStructInitializer(mockFuncConditionStructName, outputImportPath, iface.TypeParams,
+		jen.Id("f").Op(":").Id("f"),
+		jen.Id("match").Op(":").Id("match"),
+	)
+	returnStatement := compose(jen.Return(), conditionExpression)
+
+	params := []jen.Code{compose(jen.Id("match"), generateMatcherType(method))}
+	results := []jen.Code{compose(jen.Op("*"), addTypes(jen.Id(mockFuncConditionStructName), iface.TypeParams, outputImportPath, false))}
+	return generateMockFuncMethod(iface, outputImportPath, method, "WhenFunc", commentText, params, results,
+		returnStatement, // return &<prefix>FuncCondition{ f: f, match: match }
+	)
+}
+
+// generateMatcherType returns the type of the functions matching the arguments of
+// invocations of the given method.
+func generateMatcherType(method *wrappedMethod) jen.Code {
+	// func(T<n>, ...) bool
+	return jen.Func().Params(method.paramTypes...).Bool()
+}
+
+// generateEqualityMatcher returns a function matching invocations of the given method
+// with arguments equal to the values of the parameters v<n> of the enclosing method.
+func generateEqualityMatcher(method *wrappedMethod) jen.Code {
+	params := make([]jen.Code, 0, len(method.paramTypes))
+	var condition *jen.Statement
+	for i, param := range method.paramTypes {
+		name := fmt.Sprintf("a%d", i)
+		params = append(params, compose(jen.Id(name), param))
+
+		equalExpression := jen.Qual(supportImportPath, "ValuesEqual").Call(jen.Id(fmt.Sprintf("v%d", i)), jen.Id(name))
+		if condition == nil {
+			condition = equalExpression
+		} else {
+			condition = condition.Op("&&").Add(equalExpression)
+		}
+	}
+	if condition == nil {
+		// Invocations of methods without parameters always match
+		condition = jen.True()
+	}
+
+	// func(a<n> T<n>, ...) bool { return mocksupport.ValuesEqual(v<n>, a<n>) && ... }
+	return jen.Func().Params(params...).Bool().Block(jen.Return(condition))
+}
+
+func generateMockFuncExpectMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.expectations {
+		return jen.Null()
+	}
+
+	mockFuncExpectationStructName := fmt.Sprintf("%s%s%sFuncExpectation", iface.prefix, iface.titleName, method.Name)
+	commentText := strings.Join([]string{
+		fmt.Sprintf(`Expect registers an expectation that the %s method of the parent %s instance is invoked once with arguments equal to the given values.`, method.Name, iface.mockStructName),
+		`Arguments are compared in the same way as When. Use ExpectFunc to match arguments by other criteria.`,
+		`Expectations are checked before conditions and the hook queue; matching invocations without an expectation hook fall through to them.`,
+		`Unmet and over-satisfied expectations and unexpected invocations are reported by AssertExpectations.`,
+	}, " ")
+
+	params := make([]jen.Code, 0, len(method.paramTypes))
+	names := make([]jen.Code, 0, len(method.paramTypes))
+	for i, param := range method.paramTypes {
+		name := jen.Id(fmt.Sprintf("v%d", i))
+		params = append(params, compose(name, param))
+		names = append(names, name)
+	}
+
+	// return f.expect([]interface{}{v<n>, ...}, func(a<n> T<n>, ...) bool { return mocksupport.ValuesEqual(v<n>, a<n>) && ... })
+	returnStatement := jen.Return(jen.Id("f").Dot("expect").Call(jen.Index().Interface().Values(names...), generateEqualityMatcher(method)))
+
+	results := []jen.Code{compose(jen.Op("*"), addTypes(jen.Id(mockFuncExpectationStructName), iface.TypeParams, outputImportPath, false))}
+	return generateMockFuncMethod(iface, outputImportPath, method, "Expect", commentText, params, results,
+		returnStatement, // return f.expect([]interface{}{v<n>, ...}, func(a<n> T<n>, ...) bool { ... })
+	)
+}
+
+func generateMockFuncExpectFuncMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.expectations {
+		return jen.Null()
+	}
+
+	mockFuncExpectationStructName := fmt.Sprintf("%s%s%sFuncExpectation", iface.prefix, iface.titleName, method.Name)
+	commentText := strings.Join([]string{
+		fmt.Sprintf(`ExpectFunc registers an expectation that the %s method of the parent %s instance is invoked once with arguments for which the given function returns true.`, method.Name, iface.mockStructName),
+		`The function is invoked in the same way as the function given to WhenFunc.`,
+		`Expectations are checked before conditions and the hook queue; matching invocations without an expectation hook fall through to them.`,
+		`Unmet and over-satisfied expectations and unexpected invocations are reported by AssertExpectations.`,
+	}, " ")
+
+	// return f.expect([]interface{}{match}, match)
+	returnStatement := jen.Return(jen.Id("f").Dot("expect").Call(jen.Index().Interface().Values(jen.Id("match")), jen.Id("match")))
+
+	params := []jen.Code{compose(jen.Id("match"), generateMatcherType(method))}
+	results := []jen.Code{compose(jen.Op("*"), addTypes(jen.Id(mockFuncExpectationStructName), iface.TypeParams, outputImportPath, false))}
+	return generateMockFuncMethod(iface, outputImportPath, method, "ExpectFunc", commentText, params, results,
+		returnStatement, // return f.expect([]interface{}{match}, match)
+	)
+}
+
+func generateMockFuncExpectHelperMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.expectations {
+		return jen.Null()
+	}
+
+	mockFuncExpectationStructName := fmt.Sprintf("%s%s%sFuncExpectation", iface.prefix, iface.titleName, method.Name)
+	expectationDeclaration := compose(jen.Id("expectation").Op(":="), generateStructInitializer(mockFuncExpectationStructName, outputImportPath, iface.TypeParams,
+		jen.Id("f").Op(":").Id("f"),
+		jen.Id("args").Op(":").Id("args"),
+		jen.Id("match").Op(":").Id("match"),
+		jen.Id("times").Op(":").Lit(1),
+	))
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
+	appendStatement := selfAppend(jen.Id("f").Dot("expectations"), jen.Id("expectation"))
+	returnStatement := jen.Return(jen.Id("expectation"))
+
+	params := []jen.Code{
+		jen.Id("args").Index().Interface(),                    // args []interface{}
+		compose(jen.Id("match"), generateMatcherType(method)), // match func(T<n>, ...) bool
+	}
+	results := []jen.Code{compose(jen.Op("*"), addTypes(jen.Id(mockFuncExpectationStructName), iface.TypeParams, outputImportPath, false))}
+	return generateMockFuncMethod(iface, outputImportPath, method, "expect", "", params, results,
+		expectationDeclaration, jen.Line(), // expectation := &<prefix>FuncExpectation{ f: f, args: args, match: match, times: 1 }
+		lockStatement,   // f.mutex.Lock()
+		appendStatement, // f.expectations = append(f.expectations, expectation)
+		unlockStatement, // f.mutex.Unlock()
+		jen.Line(),
+		returnStatement, // return expectation
+	)
+}
+
 func generateMockFuncNextHookMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	callInfoStructName := fmt.Sprintf("%s%sCallInfo", iface.prefix, iface.titleName)
+	mockType := compose(jen.Op("*"), addTypes(jen.Id(iface.mockStructName), iface.TypeParams, outputImportPath, false))
+
+	params := make([]jen.Code, 0, len(method.dotlessParamTypes)+2)
+	if iface.callInfoHooks {
+		params = append(params, compose(jen.Id("info"), addTypes(jen.Id(callInfoStructName), iface.TypeParams, outputImportPath, false)))
+	} else if iface.returnsSelf(method) {
+		params = append(params, compose(jen.Id("mock"), mockType))
+	}
+	if iface.injectsErrors(method) {
+		params = append(params, jen.Id("fault").Qual(supportImportPath, "Fault"))
+	}
+	names := make([]jen.Code, 0, len(method.dotlessParamTypes))
+	matchArgs := make([]jen.Code, 0, len(method.dotlessParamTypes))
+	if iface.matchesArguments() {
+		// The arguments are only needed to match conditions and expectations
+		for i, param := range method.dotlessParamTypes {
+			name := jen.Id(fmt.Sprintf("v%d", i))
+			params = append(params, compose(name, param))
+			names = append(names, name)
+
+			matchArg := jen.Id(fmt.Sprintf("v%d", i))
+			if i == len(method.dotlessParamTypes)-1 && method.Variadic {
+				matchArg = compose(matchArg, jen.Op("..."))
+			}
+			matchArgs = append(matchArgs, matchArg)
+		}
+	}
+
+	mockFuncExpectationStructName := fmt.Sprintf("%s%s%sFuncExpectation", iface.prefix, iface.titleName, method.Name)
+	mockFuncConditionStructName := fmt.Sprintf("%s%s%sFuncCondition", iface.prefix, iface.titleName, method.Name)
+	expectationType := compose(jen.Op("*"), addTypes(jen.Id(mockFuncExpectationStructName), iface.TypeParams, outputImportPath, false))
+	conditionType := compose(jen.Op("*"), addTypes(jen.Id(mockFuncConditionStructName), iface.TypeParams, outputImportPath, false))
+
+	argsDeclaration := jen.Id("args").Op(":=").Index().Interface().Values(names...)
 	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
 	deferUnlockStatement := jen.Defer().Id("f").Dot("mutex").Dot("Unlock").Call()
+	// Argument matchers may invoke arbitrary code (including the mock itself), so they are
+	// evaluated against a snapshot of the expectations and conditions without holding the lock
+	var snapshotNames, snapshotValues []jen.Code
+	if iface.expectations {
+		snapshotNames = append(snapshotNames, jen.Id("expectations"))
+		snapshotValues = append(snapshotValues, jen.Id("f").Dot("expectations"))
+	}
+	if iface.conditions {
+		snapshotNames = append(snapshotNames, jen.Id("conditions"))
+		snapshotValues = append(snapshotValues, jen.Id("f").Dot("conditions"))
+	}
+	snapshotStatement := jen.List(snapshotNames...).Op(":=").List(snapshotValues...)
+	matchesDeclaration := compose(jen.Var().Id("matches").Index(), expectationType)
+	expectationMatchCondition := jen.Id("expectation").Dot("match").Call(matchArgs...)
+	expectationMatchStatement := jen.If(expectationMatchCondition).Block(selfAppend(jen.Id("matches"), jen.Id("expectation")))
+	expectationLoop := jen.For(jen.Id("_").Op(",").Id("expectation").Op(":=").Range().Id("expectations")).Block(expectationMatchStatement)
+	conditionDeclaration := compose(jen.Var().Id("condition"), conditionType)
+	conditionMatchCondition := jen.Id("c").Dot("match").Call(matchArgs...)
+	conditionMatchStatement := jen.If(conditionMatchCondition).Block(jen.Id("condition").Op("=").Id("c"), jen.Break())
+	conditionLoop := jen.For(jen.Id("_").Op(",").Id("c").Op(":=").Range().Id("conditions")).Block(conditionMatchStatement)
+	faultStatement := generateInjectedErrorStatement(iface, method)
+	expectationDeclaration := jen.Id("expectation").Op(":=").Id("f").Dot("claimExpectation").Call(jen.Id("expectations"), jen.Id("matches"), jen.Id("args"))
+	expectationCondition := jen.Id("expectation").Op("!=").Nil().Op("&&").Id("expectation").Dot("hook").Op("!=").Nil()
+	expectationStatement := jen.If(expectationCondition).Block(jen.Return(jen.Id("expectation").Dot("hook")))
+	conditionStatement := jen.If(jen.Id("condition").Op("!=").Nil()).Block(jen.Return(jen.Id("condition").Dot("hook")))
 	lenHooksExpression := jen.Len(jen.Id("f").Dot("hooks"))
+	var defaultCallHookStatement, callHookStatement jen.Code = jen.Null(), jen.Null()
+	if iface.callInfoHooks {
+		defaultCallHookStatement = jen.If(jen.Id("f").Dot("defaultCallHook").Op("!=").Nil()).Block(
+			jen.Return(jen.Id("f").Dot("bindCallHook").Call(jen.Id("f").Dot("defaultCallHook"), jen.Id("info"))),
+		)
+		// A nil entry in the hook queue stands for the call hook at the front of its queue
+		callHookStatement = jen.If(jen.Id("hook").Op("==").Nil()).Block(
+			jen.Id("callHook").Op(":=").Id("f").Dot("callHooks").Index(jen.Lit(0)),
+			jen.Id("f").Dot("callHooks").Op("=").Id("f").Dot("callHooks").Index(jen.Lit(1).Op(":")),
+			jen.Return(jen.Id("f").Dot("bindCallHook").Call(jen.Id("callHook"), jen.Id("info"))),
+		)
+	}
+	zeroResults := make([]jen.Code, 0, len(method.resultTypes))
+	mockAssignments := make([]jen.Code, 0, len(method.resultTypes))
+	for i, typ := range method.resultTypes {
+		name := fmt.Sprintf("r%d", i)
+		zeroResults = append(zeroResults, compose(jen.Id(name), typ))
+
+		if mockName, ok := iface.resultMockName(method, i); ok {
+			// r<n> = f.Result<n>Mock(), or r<n> = mock if the result is this mock's own interface
+			mockExpression := jen.Id("f").Dot(fmt.Sprintf("Result%dMock", i)).Call()
+			if mockName == iface.mockStructName && iface.callInfoHooks {
+				mockExpression = jen.Id("info").Dot("Mock")
+			} else if mockName == iface.mockStructName {
+				mockExpression = jen.Id("mock")
+			}
+			mockAssignments = append(mockAssignments, jen.Id(name).Op("=").Add(mockExpression))
+		} else if value, ok := iface.defaultValue(method, i, outputImportPath); ok {
+			// r<n> = <default value>, as returned by the noop function of the constructors
+			mockAssignments = append(mockAssignments, jen.Id(name).Op("=").Add(value))
+		}
+	}
+	zeroHookExpression := jen.Func().Params(method.paramTypes...).Params(zeroResults...).Block(append(mockAssignments, jen.Return())...)
+	var zeroHookStatement jen.Code = jen.Null()
+	if iface.zeroValueMocks || iface.returnsMocks(method) {
+		// The default hook is nil for the mock function objects of zero-value mocks and
+		// for methods returning nested mocks
+		zeroHookStatement = jen.If(jen.Id("f").Dot("defaultHook").Op("==").Nil()).Block(jen.Return(zeroHookExpression))
+	}
 	earlyReturnStatement := jen.Return(jen.Id("f").Dot("defaultHook"))
-	returnDefaultIfEmptyCondition := jen.If(lenHooksExpression.Op("==").Lit(0)).Block(earlyReturnStatement)
+	returnDefaultIfEmptyCondition := jen.If(lenHooksExpression.Op("==").Lit(0)).Block(defaultCallHookStatement, zeroHookStatement, earlyReturnStatement)
 	firstHookStatement := jen.Id("hook").Op(":=").Id("f").Dot("hooks").Index(jen.Lit(0))
 	popHookStatement := jen.Id("f").Dot("hooks").Op("=").Id("f").Dot("hooks").Index(jen.Lit(1).Op(":"))
 	returnStatement := jen.Return(jen.Id("hook"))
 
+	var body []jen.Code
+	if iface.matchesArguments() {
+		if iface.expectations {
+			body = append(body, argsDeclaration, jen.Line()) // args := []interface{}{ v<n>, ... }
+		}
+		body = append(body, lockStatement)               // f.mutex.Lock()
+		body = append(body, snapshotStatement)           // [expectations, ][conditions] := [f.expectations, ][f.conditions]
+		body = append(body, unlockStatement, jen.Line()) // f.mutex.Unlock()
+	}
+	if iface.expectations {
+		body = append(body, matchesDeclaration)          // var matches []*<prefix>FuncExpectation
+		body = append(body, expectationLoop, jen.Line()) // for _, expectation := range expectations { if expectation.match(v<n>, ...) { matches = append(matches, expectation) } }
+	}
+	if iface.conditions {
+		body = append(body, conditionDeclaration)      // var condition *<prefix>FuncCondition
+		body = append(body, conditionLoop, jen.Line()) // for _, c := range conditions { if c.match(v<n>, ...) { condition = c; break } }
+	}
+	body = append(body, lockStatement)                    // f.mutex.Lock()
+	body = append(body, deferUnlockStatement, jen.Line()) // defer f.mutex.Unlock()
+	if iface.expectations {
+		body = append(body, expectationDeclaration) // expectation := f.claimExpectation(expectations, matches, args)
+	}
+	body = append(body, faultStatement) // if fault.Err != nil { return func(...) (r<n> R<n>, ...) { return r<n>, ..., fault.Err } } (if enabled and method returns an error)
+	if iface.expectations {
+		body = append(body, expectationStatement) // if expectation != nil && expectation.hook != nil { return expectation.hook }
+	}
+	if iface.conditions {
+		body = append(body, conditionStatement, jen.Line()) // if condition != nil { return condition.hook }
+	}
+	body = append(body, returnDefaultIfEmptyCondition, jen.Line()) // if len(f.hooks) == 0 { [if f.defaultCallHook != nil { return f.bindCallHook(f.defaultCallHook, info) }; ][if f.defaultHook == nil { return func(...) (r<n> R<n>, ...) { [r<n> = <nested mock or default value>; ...] return } }; ]return f.defaultHook }
+	body = append(body, firstHookStatement)                        // hook := f.hooks[0]
+	body = append(body, popHookStatement)                          // f.hooks = f.hooks[1:]
+	if iface.callInfoHooks {
+		body = append(body, jen.Line(), callHookStatement) // if hook == nil { callHook := f.callHooks[0]; f.callHooks = f.callHooks[1:]; return f.bindCallHook(callHook, info) }
+	}
+	body = append(body, returnStatement) // return hook
+
 	results := []jen.Code{method.signature}
-	return generateMockFuncMethod(iface, outputImportPath, method, "nextHook", "", nil, results,
+	return generateMockFuncMethod(iface, outputImportPath, method, "nextHook", "", params, results, body...)
+}
+
+func generateInjectedErrorStatement(iface *wrappedInterface, method *wrappedMethod) jen.Code {
+	if !iface.injectsErrors(method) {
+		return jen.Null()
+	}
+
+	lastIndex := len(method.resultTypes) - 1
+	results := make([]jen.Code, 0, len(method.resultTypes))
+	values := make([]jen.Code, 0, len(method.resultTypes))
+	for i, typ := range method.resultTypes {
+		name := jen.Id(fmt.Sprintf("r%d", i))
+		results = append(results, compose(name, typ))
+
+		if i == lastIndex {
+			values = append(values, jen.Id("fault").Dot("Err"))
+		} else {
+			values = append(values, name)
+		}
+	}
+
+	// if fault.Err != nil { return func( T<n>, ... ) (r<n> R<n>, ...) { return r<n>, ..., fault.Err } }
+	functionExpression := jen.Func().Params(method.paramTypes...).Params(results...).Block(jen.Return().List(values...))
+	return jen.If(jen.Id("fault").Dot("Err").Op("!=").Nil()).Block(jen.Return(functionExpression))
+}
+
+func generateMockFuncReserveCallMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.callInfoHooks {
+		return jen.Null()
+	}
+
+	callInfoStructName := fmt.Sprintf("%s%sCallInfo", iface.prefix, iface.titleName)
+	mockFuncCallStructName := fmt.Sprintf("%s%s%sFuncCall", iface.prefix, iface.titleName, method.Name)
+	mockType := compose(jen.Op("*"), addTypes(jen.Id(iface.mockStructName), iface.TypeParams, outputImportPath, false))
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	deferUnlockStatement := jen.Defer().Id("f").Dot("mutex").Dot("Unlock").Call()
+	lenHistoryExpression := jen.Len(jen.Id("f").Dot("history"))
+	infoDeclaration := jen.Id("info").Op(":=").Add(addTypes(jen.Id(callInfoStructName), iface.TypeParams, outputImportPath, false)).Values(
+		jen.Id("Index").Op(":").Add(lenHistoryExpression),
+		jen.Id("Mock").Op(":").Id("mock"),
+		jen.Id("Method").Op(":").Lit(method.Name),
+		jen.Id("slot").Op(":").Id("f").Dot("cleared").Op("+").Len(jen.Id("f").Dot("history")),
+	)
+	appendStatement := selfAppend(jen.Id("f").Dot("history"), jen.Id("r0"))
+	returnStatement := jen.Return(jen.Id("info"))
+
+	params := []jen.Code{
+		compose(jen.Id("mock"), mockType),
+		compose(jen.Id("r0"), addTypes(jen.Id(mockFuncCallStructName), iface.TypeParams, outputImportPath, false)),
+	}
+	results := []jen.Code{addTypes(jen.Id(callInfoStructName), iface.TypeParams, outputImportPath, false)}
+	return generateMockFuncMethod(iface, outputImportPath, method, "reserveCall", "", params, results,
 		lockStatement,                    // f.mutex.Lock()
 		deferUnlockStatement, jen.Line(), // defer f.mutex.Unlock()
-		returnDefaultIfEmptyCondition, jen.Line(), // if len(f.hooks) == 0 { return f.defaultHook }
-		firstHookStatement, // hook := f.hooks[0]
-		popHookStatement,   // f.hooks = f.hooks[1:]
-		returnStatement,    // return hook
+		infoDeclaration, // info := <prefix>CallInfo{Index: len(f.history), Mock: mock, Method: "<MethodName>", slot: f.cleared + len(f.history)}
+		appendStatement, // f.history = append(f.history, r0)
+		returnStatement, // return info
+	)
+}
+
+func generateMockFuncBindCallHookMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.callInfoHooks {
+		return jen.Null()
+	}
+
+	callInfoStructName := fmt.Sprintf("%s%sCallInfo", iface.prefix, iface.titleName)
+	infoType := addTypes(jen.Id(callInfoStructName), iface.TypeParams, outputImportPath, false)
+
+	params := make([]jen.Code, 0, len(method.paramTypes))
+	args := make([]jen.Code, 0, len(method.paramTypes)+1)
+	args = append(args, jen.Id("info"))
+	for i, param := range method.paramTypes {
+		name := jen.Id(fmt.Sprintf("v%d", i))
+		params = append(params, compose(name, param))
+
+		if i == len(method.paramTypes)-1 && method.Variadic {
+			name = compose(name, jen.Op("..."))
+		}
+		args = append(args, name)
+	}
+
+	var callStatement jen.Code = jen.Id("hook").Call(args...)
+	if len(method.resultTypes) != 0 {
+		callStatement = jen.Return(callStatement)
+	}
+	functionExpression := jen.Func().Params(params...).Params(method.resultTypes...).Block(callStatement)
+	returnStatement := jen.Return(functionExpression)
+
+	hookParams := []jen.Code{
+		compose(jen.Id("hook"), callHookSignature(iface, method, outputImportPath)),
+		compose(jen.Id("info"), infoType),
+	}
+	results := []jen.Code{method.signature}
+	return generateMockFuncMethod(iface, outputImportPath, method, "bindCallHook", "", hookParams, results,
+		returnStatement, // return func(v<n> T<n>, ...) { return hook(info, v<n>, ...) }
+	)
+}
+
+func generateMockFuncClaimExpectationMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.expectations {
+		return jen.Null()
+	}
+
+	mockFuncExpectationStructName := fmt.Sprintf("%s%s%sFuncExpectation", iface.prefix, iface.titleName, method.Name)
+	expectationType := compose(jen.Op("*"), addTypes(jen.Id(mockFuncExpectationStructName), iface.TypeParams, outputImportPath, false))
+
+	returnIfEmptyCondition := jen.If(jen.Len(jen.Id("expectations")).Op("==").Lit(0)).Block(jen.Return(jen.Nil()))
+	matchDeclaration := compose(jen.Var().Id("match"), expectationType)
+	breakIfUnsatisfied := jen.If(jen.Id("expectation").Dot("calls").Op("<").Id("expectation").Dot("times")).Block(jen.Break())
+	expectationLoop := jen.For(jen.Id("_").Op(",").Id("expectation").Op(":=").Range().Id("matches")).Block(jen.Id("match").Op("=").Id("expectation"), breakIfUnsatisfied)
+	unexpectedStatement := jen.If(jen.Id("match").Op("==").Nil()).Block(
+		selfAppend(jen.Id("f").Dot("unexpected"), jen.Id("args")),
+		jen.Return(jen.Nil()),
+	)
+	incrementStatement := jen.Id("match").Dot("calls").Op("++")
+	returnStatement := jen.Return(jen.Id("match"))
+
+	params := []jen.Code{jen.List(jen.Id("expectations"), jen.Id("matches")).Index().Add(expectationType), jen.Id("args").Index().Interface()}
+	results := []jen.Code{expectationType}
+	return generateMockFuncMethod(iface, outputImportPath, method, "claimExpectation", "", params, results,
+		returnIfEmptyCondition, jen.Line(), // if len(expectations) == 0 { return nil }
+		matchDeclaration,            // var match *<prefix>FuncExpectation
+		expectationLoop, jen.Line(), // for _, expectation := range matches { match = expectation; if expectation.calls < expectation.times { break } }
+		unexpectedStatement, jen.Line(), // if match == nil { f.unexpected = append(f.unexpected, args); return nil }
+		incrementStatement, // match.calls++
+		returnStatement,    // return match
 	)
 }
 
@@ -100,12 +859,48 @@ func generateMockFuncAppendCallMethod(iface *wrappedInterface, method *wrappedMe
 	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
 	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
 	appendStatement := selfAppend(jen.Id("f").Dot("history"), jen.Id("r0"))
+	if iface.callInfoHooks {
+		// The invocation fills the history slot reserved when it began, unless the
+		// history has been cleared since
+		slotCondition := jen.Id("i").Op(":=").Id("r0").Dot("slot").Op("-").Id("f").Dot("cleared").Op(";").Id("i").Op(">=").Lit(0)
+		appendStatement = jen.If(slotCondition).Block(jen.Id("f").Dot("history").Index(jen.Id("i")).Op("=").Id("r0"))
+	}
+	var signalStatement, publishStatement jen.Code = jen.Null(), jen.Null()
+	if iface.subscriptions {
+		signalStatement = jen.If(jen.Id("f").Dot("callSignal").Op("!=").Nil()).Block(
+			jen.Close(jen.Id("f").Dot("callSignal")),
+			jen.Id("f").Dot("callSignal").Op("=").Nil(),
+		)
+		publishStatement = jen.Id("f").Dot("subscriptions").Dot("Publish").Call(jen.Id("r0"))
+	}
 
 	params := []jen.Code{compose(jen.Id("r0"), addTypes(jen.Id(mockFuncCallStructName), iface.TypeParams, outputImportPath, false))}
 	return generateMockFuncMethod(iface, outputImportPath, method, "appendCall", "", params, nil,
-		lockStatement,   // f.mutex.Lock()
-		appendStatement, // f.history = append(f.history, r0)
-		unlockStatement, // f.mutex.Unlock()
+		lockStatement,    // f.mutex.Lock()
+		appendStatement,  // f.history = append(f.history, r0), or if i := r0.slot - f.cleared; i >= 0 { f.history[i] = r0 } (if enabled)
+		signalStatement,  // if f.callSignal != nil { close(f.callSignal); f.callSignal = nil } (if enabled)
+		publishStatement, // f.subscriptions.Publish(r0) (if enabled)
+		unlockStatement,  // f.mutex.Unlock()
+	)
+}
+
+func generateMockFuncRecoverCallMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.recordPanics {
+		return jen.Null()
+	}
+
+	mockFuncCallStructName := fmt.Sprintf("%s%s%sFuncCall", iface.prefix, iface.titleName, method.Name)
+
+	recoverCondition := jen.Id("p").Op(":=").Recover().Op(";").Id("p").Op("!=").Nil()
+	recoverStatement := jen.If(recoverCondition).Block(
+		jen.Id("r0").Dot("panicValue").Op("=").Id("p"),
+		jen.Id("f").Dot("appendCall").Call(jen.Id("r0")),
+		jen.Panic(jen.Id("p")),
+	)
+
+	params := []jen.Code{compose(jen.Id("r0"), addTypes(jen.Id(mockFuncCallStructName), iface.TypeParams, outputImportPath, false))}
+	return generateMockFuncMethod(iface, outputImportPath, method, "recoverCall", "", params, nil,
+		recoverStatement, // if p := recover(); p != nil { r0.panicValue = p; f.appendCall(r0); panic(p) }
 	)
 }
 
@@ -115,6 +910,9 @@ func generateMockFuncHistoryMethod(iface *wrappedInterface, method *wrappedMetho
 		`History returns a sequence of %s objects describing the invocations of this function.`,
 		mockFuncCallStructName,
 	)
+	if iface.callInfoHooks {
+		commentText += ` Invocations are recorded when they begin, and the results of invocations that have not yet returned are zero values.`
+	}
 
 	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
 	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
@@ -134,6 +932,370 @@ func generateMockFuncHistoryMethod(iface *wrappedInterface, method *wrappedMetho
 	)
 }
 
+func generateMockFuncClearHistoryMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.resetMethods {
+		return jen.Null()
+	}
+
+	commentText := `ClearHistory discards the record of all previous invocations of this function.`
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
+	clearStatement := jen.Id("f").Dot("history").Op("=").Nil()
+
+	return generateMockFuncMethod(iface, outputImportPath, method, "ClearHistory", commentText, nil, nil,
+		lockStatement,                               // f.mutex.Lock()
+		generateCountClearedStatement(iface),        // f.cleared += len(f.history) (if enabled)
+		clearStatement,                              // f.history = nil
+		generateResetMaxConcurrencyStatement(iface), // f.maxInFlight = f.inFlight (if enabled)
+		unlockStatement,                             // f.mutex.Unlock()
+	)
+}
+
+func generateCountClearedStatement(iface *wrappedInterface) jen.Code {
+	if !iface.callInfoHooks {
+		return jen.Null()
+	}
+
+	// Invocations that are still in flight hold a slot in the discarded history
+	return jen.Id("f").Dot("cleared").Op("+=").Len(jen.Id("f").Dot("history"))
+}
+
+func generateResetMaxConcurrencyStatement(iface *wrappedInterface) jen.Code {
+	if !iface.trackConcurrency {
+		return jen.Null()
+	}
+
+	// Invocations that are still in flight remain counted
+	return jen.Id("f").Dot("maxInFlight").Op("=").Id("f").Dot("inFlight")
+}
+
+func generateMockFuncResultMockMethods(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	var methods []jen.Code
+	for i := range method.Results {
+		mockName, ok := iface.resultMockName(method, i)
+		if !ok || mockName == iface.mockStructName {
+			continue
+		}
+
+		methodName := fmt.Sprintf("Result%dMock", i)
+		fieldName := fmt.Sprintf("result%dMock", i)
+		commentText := fmt.Sprintf(`%s returns the mock returned as the %s result of invocations of this function that are not handled by a hook.`, methodName, humanize.Ordinal(i+1))
+		if iface.resetMethods {
+			commentText += ` The mock is created on first use and discarded by Reset.`
+		} else {
+			commentText += ` The mock is created on first use.`
+		}
+
+		lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+		deferUnlockStatement := jen.Defer().Id("f").Dot("mutex").Dot("Unlock").Call()
+		constructorCall := jen.Id(fmt.Sprintf("New%s%s", iface.constructorPrefix, mockName)).Call()
+		createStatement := jen.If(jen.Id("f").Dot(fieldName).Op("==").Nil()).Block(jen.Id("f").Dot(fieldName).Op("=").Add(constructorCall))
+		returnStatement := jen.Return(jen.Id("f").Dot(fieldName))
+
+		if len(methods) != 0 {
+			methods = append(methods, jen.Line(), jen.Line())
+		}
+		results := []jen.Code{jen.Op("*").Id(mockName)}
+		methods = append(methods, generateMockFuncMethod(iface, outputImportPath, method, methodName, commentText, nil, results,
+			lockStatement,                    // f.mutex.Lock()
+			deferUnlockStatement, jen.Line(), // defer f.mutex.Unlock()
+			createStatement, // if f.result<n>Mock == nil { f.result<n>Mock = New<ResultMockStruct>() }
+			returnStatement, // return f.result<n>Mock
+		))
+	}
+
+	return compose(jen.Null(), methods...)
+}
+
+func generateResetResultMocksStatements(iface *wrappedInterface, method *wrappedMethod) []jen.Code {
+	var statements []jen.Code
+	for i := range method.Results {
+		if mockName, ok := iface.resultMockName(method, i); ok && mockName != iface.mockStructName {
+			// f.result<n>Mock = nil
+			statements = append(statements, jen.Id("f").Dot(fmt.Sprintf("result%dMock", i)).Op("=").Nil())
+		}
+	}
+
+	return statements
+}
+
+func generateMockFuncClearHooksMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.resetMethods {
+		return jen.Null()
+	}
+
+	commentText := `ClearHooks discards all hooks and return values that have been pushed onto the hook queue but not yet invoked.`
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
+	clearStatement := jen.Id("f").Dot("hooks").Op("=").Nil()
+	var clearCallHooksStatement jen.Code = jen.Null()
+	if iface.callInfoHooks {
+		clearCallHooksStatement = jen.Id("f").Dot("callHooks").Op("=").Nil()
+	}
+
+	return generateMockFuncMethod(iface, outputImportPath, method, "ClearHooks", commentText, nil, nil,
+		lockStatement,           // f.mutex.Lock()
+		clearStatement,          // f.hooks = nil
+		clearCallHooksStatement, // f.callHooks = nil (if enabled)
+		unlockStatement,         // f.mutex.Unlock()
+	)
+}
+
+func generateMockFuncResetMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.resetMethods {
+		return jen.Null()
+	}
+
+	commentText := strings.Join([]string{
+		`Reset restores this function to the state in which it was constructed.`,
+		resetCommentText(iface),
+	}, " ")
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	deferUnlockStatement := jen.Defer().Id("f").Dot("mutex").Dot("Unlock").Call()
+	restoreStatement := jen.If(jen.Id("f").Dot("initialHookSaved")).Block(jen.Id("f").Dot("defaultHook").Op("=").Id("f").Dot("initialHook"))
+
+	fields := []string{"hooks"}
+	if iface.callInfoHooks {
+		fields = append(fields, "defaultCallHook", "callHooks")
+	}
+	if iface.conditions {
+		fields = append(fields, "conditions")
+	}
+	if iface.expectations {
+		fields = append(fields, "expectations", "unexpected")
+	}
+	fields = append(fields, "history")
+
+	clearStatements := make([]jen.Code, 0, len(fields))
+	for _, field := range fields {
+		clearStatements = append(clearStatements, jen.Id("f").Dot(field).Op("=").Nil())
+	}
+	var resetFaultsStatement jen.Code = jen.Null()
+	if iface.faultInjection {
+		resetFaultsStatement = jen.Id("f").Dot("faults").Dot("Reset").Call()
+	}
+
+	body := []jen.Code{lockStatement, deferUnlockStatement, jen.Line()}       // f.mutex.Lock(); defer f.mutex.Unlock()
+	body = append(body, restoreStatement)                                     // if f.initialHookSaved { f.defaultHook = f.initialHook }
+	body = append(body, generateCountClearedStatement(iface))                 // f.cleared += len(f.history) (if enabled)
+	body = append(body, clearStatements...)                                   // f.<field> = nil, ...
+	body = append(body, resetFaultsStatement)                                 // f.faults.Reset() (if enabled)
+	body = append(body, generateResetMaxConcurrencyStatement(iface))          // f.maxInFlight = f.inFlight (if enabled)
+	body = append(body, generateResetResultMocksStatements(iface, method)...) // f.result<n>Mock = nil, ... (if enabled)
+
+	return generateMockFuncMethod(iface, outputImportPath, method, "Reset", commentText, nil, nil, body...)
+}
+
+// resetCommentText describes the effect of the Reset method of a mock function.
+func resetCommentText(iface *wrappedInterface) string {
+	if iface.faultInjection {
+		return fmt.Sprintf(`The default hook set by the constructor is restored, %s are discarded, and fault injection is disabled.`, resetStateText(iface))
+	}
+
+	return fmt.Sprintf(`The default hook set by the constructor is restored, and %s are discarded.`, resetStateText(iface))
+}
+
+func generateMockFuncWaitForCallsMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.subscriptions {
+		return jen.Null()
+	}
+
+	commentLines := []string{`WaitForCalls blocks until this function has been invoked at least n times or the given context is canceled.`}
+	if iface.resetMethods {
+		commentLines = append(commentLines, `Invocations are counted from the most recent call to ClearHistory or Reset.`)
+	}
+	commentLines = append(commentLines, `The context error is returned if the context is canceled first.`)
+	commentText := strings.Join(commentLines, " ")
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
+	doneStatement := jen.If(jen.Len(jen.Id("f").Dot("history")).Op(">=").Id("n")).Block(unlockStatement, jen.Return(jen.Nil()))
+	makeSignalStatement := jen.If(jen.Id("f").Dot("callSignal").Op("==").Nil()).Block(jen.Id("f").Dot("callSignal").Op("=").Make(jen.Chan().Struct()))
+	signalDeclaration := jen.Id("signal").Op(":=").Id("f").Dot("callSignal")
+	selectStatement := jen.Select().Block(
+		jen.Case(jen.Op("<-").Id("signal")),
+		jen.Case(jen.Op("<-").Id("ctx").Dot("Done").Call()).Block(jen.Return(jen.Id("ctx").Dot("Err").Call())),
+	)
+	loopStatement := jen.For().Block(
+		lockStatement,               // f.mutex.Lock()
+		doneStatement,               // if len(f.history) >= n { f.mutex.Unlock(); return nil }
+		makeSignalStatement,         // if f.callSignal == nil { f.callSignal = make(chan struct{}) }
+		signalDeclaration,           // signal := f.callSignal
+		unlockStatement, jen.Line(), // f.mutex.Unlock()
+		selectStatement, // select { case <-signal: case <-ctx.Done(): return ctx.Err() }
+	)
+
+	params := []jen.Code{jen.Id("ctx").Qual("context", "Context"), jen.Id("n").Int()}
+	results := []jen.Code{jen.Error()}
+	return generateMockFuncMethod(iface, outputImportPath, method, "WaitForCalls", commentText, params, results,
+		loopStatement, // for { ... }
+	)
+}
+
+func generateMockFuncCallsMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.subscriptions {
+		return jen.Null()
+	}
+
+	mockFuncCallStructName := fmt.Sprintf("%s%s%sFuncCall", iface.prefix, iface.titleName, method.Name)
+	commentText := strings.Join([]string{
+		fmt.Sprintf(`Calls returns a channel that receives a %s object for each invocation of this function made after this call, until the given context is canceled.`, mockFuncCallStructName),
+		`Invocations never block on the channel: values are buffered until they are received.`,
+		`Once the context is canceled, buffered values are discarded and the channel is closed.`,
+	}, " ")
+
+	returnStatement := jen.Return(jen.Id("f").Dot("subscriptions").Dot("Subscribe").Call(jen.Id("ctx")))
+
+	params := []jen.Code{jen.Id("ctx").Qual("context", "Context")}
+	results := []jen.Code{compose(jen.Op("<-").Chan(), addTypes(jen.Id(mockFuncCallStructName), iface.TypeParams, outputImportPath, false))}
+	return generateMockFuncMethod(iface, outputImportPath, method, "Calls", commentText, params, results,
+		returnStatement, // return f.subscriptions.Subscribe(ctx)
+	)
+}
+
+func generateMockFuncPendingHooksMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.pendingHooks {
+		return jen.Null()
+	}
+
+	commentText := `PendingHooks returns the number of pushed hooks and return values that have not yet been invoked.`
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	deferUnlockStatement := jen.Defer().Id("f").Dot("mutex").Dot("Unlock").Call()
+	returnStatement := jen.Return(jen.Len(jen.Id("f").Dot("hooks")))
+
+	results := []jen.Code{jen.Int()}
+	return generateMockFuncMethod(iface, outputImportPath, method, "PendingHooks", commentText, nil, results,
+		lockStatement,                    // f.mutex.Lock()
+		deferUnlockStatement, jen.Line(), // defer f.mutex.Unlock()
+		returnStatement, // return len(f.hooks)
+	)
+}
+
+func generateMockFuncBeginCallMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.trackConcurrency {
+		return jen.Null()
+	}
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	incrementStatement := jen.Id("f").Dot("inFlight").Op("++")
+	maxStatement := jen.If(jen.Id("f").Dot("inFlight").Op(">").Id("f").Dot("maxInFlight")).Block(jen.Id("f").Dot("maxInFlight").Op("=").Id("f").Dot("inFlight"))
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
+
+	return generateMockFuncMethod(iface, outputImportPath, method, "beginCall", "", nil, nil,
+		lockStatement,      // f.mutex.Lock()
+		incrementStatement, // f.inFlight++
+		maxStatement,       // if f.inFlight > f.maxInFlight { f.maxInFlight = f.inFlight }
+		unlockStatement,    // f.mutex.Unlock()
+	)
+}
+
+func generateMockFuncEndCallMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.trackConcurrency {
+		return jen.Null()
+	}
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	decrementStatement := jen.Id("f").Dot("inFlight").Op("--")
+	unlockStatement := jen.Id("f").Dot("mutex").Dot("Unlock").Call()
+
+	return generateMockFuncMethod(iface, outputImportPath, method, "endCall", "", nil, nil,
+		lockStatement,      // f.mutex.Lock()
+		decrementStatement, // f.inFlight--
+		unlockStatement,    // f.mutex.Unlock()
+	)
+}
+
+func generateMockFuncInFlightMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.trackConcurrency {
+		return jen.Null()
+	}
+
+	commentText := `InFlight returns the number of invocations of this function that have begun but not yet returned.`
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	deferUnlockStatement := jen.Defer().Id("f").Dot("mutex").Dot("Unlock").Call()
+	returnStatement := jen.Return(jen.Id("f").Dot("inFlight"))
+
+	results := []jen.Code{jen.Int()}
+	return generateMockFuncMethod(iface, outputImportPath, method, "InFlight", commentText, nil, results,
+		lockStatement,                    // f.mutex.Lock()
+		deferUnlockStatement, jen.Line(), // defer f.mutex.Unlock()
+		returnStatement, // return f.inFlight
+	)
+}
+
+func generateMockFuncMaxConcurrencyMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.trackConcurrency {
+		return jen.Null()
+	}
+
+	commentText := `MaxConcurrency returns the maximum number of invocations of this function that were in flight at the same time.`
+	if iface.resetMethods {
+		commentText += ` The maximum is counted from the most recent call to ClearHistory or Reset.`
+	}
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	deferUnlockStatement := jen.Defer().Id("f").Dot("mutex").Dot("Unlock").Call()
+	returnStatement := jen.Return(jen.Id("f").Dot("maxInFlight"))
+
+	results := []jen.Code{jen.Int()}
+	return generateMockFuncMethod(iface, outputImportPath, method, "MaxConcurrency", commentText, nil, results,
+		lockStatement,                    // f.mutex.Lock()
+		deferUnlockStatement, jen.Line(), // defer f.mutex.Unlock()
+		returnStatement, // return f.maxInFlight
+	)
+}
+
+func generateMockFuncExpectationFailuresMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.expectations {
+		return jen.Null()
+	}
+
+	name := jen.Lit(fmt.Sprintf("%s.%s", iface.mockStructName, method.Name))
+
+	lockStatement := jen.Id("f").Dot("mutex").Dot("Lock").Call()
+	deferUnlockStatement := jen.Defer().Id("f").Dot("mutex").Dot("Unlock").Call()
+	failuresDeclaration := jen.Var().Id("failures").Index().String()
+	failureExpression := jen.Qual(supportImportPath, "ExpectationFailure").Call(name, jen.Id("expectation").Dot("args"), jen.Id("expectation").Dot("times"), jen.Id("expectation").Dot("calls"))
+	failureStatement := jen.If(jen.Id("failure").Op(":=").Add(failureExpression).Op(";").Id("failure").Op("!=").Lit("")).Block(selfAppend(jen.Id("failures"), jen.Id("failure")))
+	expectationLoop := jen.For(jen.Id("_").Op(",").Id("expectation").Op(":=").Range().Id("f").Dot("expectations")).Block(failureStatement)
+	unexpectedExpression := jen.Qual(supportImportPath, "UnexpectedCallFailure").Call(name, jen.Id("args"))
+	unexpectedLoop := jen.For(jen.Id("_").Op(",").Id("args").Op(":=").Range().Id("f").Dot("unexpected")).Block(selfAppend(jen.Id("failures"), unexpectedExpression))
+	returnStatement := jen.Return(jen.Id("failures"))
+
+	results := []jen.Code{jen.Index().String()}
+	return generateMockFuncMethod(iface, outputImportPath, method, "expectationFailures", "", nil, results,
+		lockStatement,                    // f.mutex.Lock()
+		deferUnlockStatement, jen.Line(), // defer f.mutex.Unlock()
+		failuresDeclaration,        // var failures []string
+		expectationLoop,            // for _, expectation := range f.expectations { if failure := mocksupport.ExpectationFailure(...); failure != "" { failures = append(failures, failure) } }
+		unexpectedLoop, jen.Line(), // for _, args := range f.unexpected { failures = append(failures, mocksupport.UnexpectedCallFailure(...)) }
+		returnStatement, // return failures
+	)
+}
+
+// callHookSignature returns the type of a hook that receives a description of the
+// invocation along with the arguments of the given method.
+func callHookSignature(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	callInfoStructName := fmt.Sprintf("%s%sCallInfo", iface.prefix, iface.titleName)
+	infoType := addTypes(jen.Id(callInfoStructName), iface.TypeParams, outputImportPath, false)
+	if method.namedParams {
+		// func(info <prefix>CallInfo, <Name #n> <Param #n>, ...) (<Result #n>, ...)
+		params := append([]jen.Code{compose(jen.Id("info"), infoType)}, method.namedParamTypes()...)
+		return jen.Func().Params(params...).Params(method.resultTypes...)
+	}
+
+	// func(<prefix>CallInfo, <Param #n>, ...) (<Result #n>, ...)
+	params := make([]jen.Code, 0, len(method.paramTypes)+1)
+	params = append(params, infoType)
+	params = append(params, method.paramTypes...)
+	return jen.Func().Params(params...).Params(method.resultTypes...)
+}
+
 func generateMockFuncMethod(
 	iface *wrappedInterface,
 	outputImportPath string,
@@ -9,12 +9,19 @@ import (
 
 func TestGenerateMockFuncSetHookMethod(t *testing.T) {
 	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.resetMethods = true
 	code := generateMockFuncSetHookMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
 	expected := strip(`
 		// SetDefaultHook sets function that is called when the Do method of the
 		// parent MockTestClient instance is invoked and the hook queue is empty.
 		func (f *TestClientDoFunc) SetDefaultHook(hook func(string) bool) {
+			f.mutex.Lock()
+			if !f.initialHookSaved {
+				f.initialHook = f.defaultHook
+				f.initialHookSaved = true
+			}
 			f.defaultHook = hook
+			f.mutex.Unlock()
 		}
 	`)
 	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
@@ -96,20 +103,112 @@ func TestGenerateMockFuncPushReturnMethod(t *testing.T) {
 	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
 }
 
+func TestGenerateMockFuncWhenMethod(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDof)
+	wrappedInterface.conditions = true
+	code := generateMockFuncWhenMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		// When returns a condition matching invocations of the Dof method of the
+		// parent MockTestClient instance with arguments equal to the given values.
+		// Arguments are compared via reflect.DeepEqual, except that byte slices are
+		// compared by content. Use WhenFunc to match arguments by other criteria.
+		// The condition takes effect once a hook is registered on it.
+		func (f *TestClientDofFunc) When(v0 string, v1 ...string) *TestClientDofFuncCondition {
+			return f.WhenFunc(func(a0 string, a1 ...string) bool {
+				return mocksupport.ValuesEqual(v0, a0) && mocksupport.ValuesEqual(v1, a1)
+			})
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncWhenFuncMethod(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDof)
+	wrappedInterface.conditions = true
+	code := generateMockFuncWhenFuncMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		// WhenFunc returns a condition matching invocations of the Dof method of
+		// the parent MockTestClient instance for which the given function returns
+		// true. The function is invoked with the arguments of each invocation
+		// without holding the lock of this mock function, so it may inspect or
+		// invoke the mock. The condition takes effect once a hook is registered on
+		// it.
+		func (f *TestClientDofFunc) WhenFunc(match func(string, ...string) bool) *TestClientDofFuncCondition {
+			return &TestClientDofFuncCondition{
+				f:     f,
+				match: match,
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncWhenMethodNoParams(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus)
+	wrappedInterface.conditions = true
+	code := generateMockFuncWhenMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		// When returns a condition matching invocations of the Status method of the
+		// parent MockTestClient instance with arguments equal to the given values.
+		// Arguments are compared via reflect.DeepEqual, except that byte slices are
+		// compared by content. Use WhenFunc to match arguments by other criteria.
+		// The condition takes effect once a hook is registered on it.
+		func (f *TestClientStatusFunc) When() *TestClientStatusFuncCondition {
+			return f.WhenFunc(func() bool {
+				return true
+			})
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
 func TestGenerateMockFuncNextHookMethod(t *testing.T) {
 	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.conditions = true
+	wrappedInterface.callInfoHooks = true
+	wrappedInterface.zeroValueMocks = true
 	code := generateMockFuncNextHookMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
 	expected := strip(`
-		func (f *TestClientDoFunc) nextHook() func(string) bool {
+		func (f *TestClientDoFunc) nextHook(info TestClientCallInfo, v0 string) func(string) bool {
+			f.mutex.Lock()
+			conditions := f.conditions
+			f.mutex.Unlock()
+
+			var condition *TestClientDoFuncCondition
+			for _, c := range conditions {
+				if c.match(v0) {
+					condition = c
+					break
+				}
+			}
+
 			f.mutex.Lock()
 			defer f.mutex.Unlock()
 
+			if condition != nil {
+				return condition.hook
+			}
+
 			if len(f.hooks) == 0 {
+				if f.defaultCallHook != nil {
+					return f.bindCallHook(f.defaultCallHook, info)
+				}
+				if f.defaultHook == nil {
+					return func(string) (r0 bool) {
+						return
+					}
+				}
 				return f.defaultHook
 			}
 
 			hook := f.hooks[0]
 			f.hooks = f.hooks[1:]
+
+			if hook == nil {
+				callHook := f.callHooks[0]
+				f.callHooks = f.callHooks[1:]
+				return f.bindCallHook(callHook, info)
+			}
 			return hook
 		}
 	`)
@@ -129,6 +228,39 @@ func TestGenerateMockFuncAppendCallMethod(t *testing.T) {
 	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
 }
 
+func TestGenerateMockFuncAppendCallMethodCallInfoHooks(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.callInfoHooks = true
+	code := generateMockFuncAppendCallMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		func (f *TestClientDoFunc) appendCall(r0 TestClientDoFuncCall) {
+			f.mutex.Lock()
+			if i := r0.slot - f.cleared; i >= 0 {
+				f.history[i] = r0
+			}
+			f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncReserveCallMethod(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.callInfoHooks = true
+	code := generateMockFuncReserveCallMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		func (f *TestClientDoFunc) reserveCall(mock *MockTestClient, r0 TestClientDoFuncCall) TestClientCallInfo {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			info := TestClientCallInfo{Index: len(f.history), Mock: mock, Method: "Do", slot: f.cleared + len(f.history)}
+			f.history = append(f.history, r0)
+			return info
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
 func TestGenerateMockFuncHistoryMethod(t *testing.T) {
 	wrappedInterface := makeInterface(TestMethodDo)
 	code := generateMockFuncHistoryMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
@@ -146,3 +278,865 @@ func TestGenerateMockFuncHistoryMethod(t *testing.T) {
 	`)
 	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
 }
+
+func TestGenerateMockFuncExpectMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.expectations = true
+	code := generateMockFuncExpectMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Expect registers an expectation that the Do method of the parent
+		// MockTestClient instance is invoked once with arguments equal to the given
+		// values. Arguments are compared in the same way as When. Use ExpectFunc to
+		// match arguments by other criteria. Expectations are checked before
+		// conditions and the hook queue; matching invocations without an
+		// expectation hook fall through to them. Unmet and over-satisfied
+		// expectations and unexpected invocations are reported by
+		// AssertExpectations.
+		func (f *TestClientDoFunc) Expect(v0 string) *TestClientDoFuncExpectation {
+			return f.expect([]interface{}{v0}, func(a0 string) bool {
+				return mocksupport.ValuesEqual(v0, a0)
+			})
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncExpectFuncMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDof)
+	wrappedInterface.expectations = true
+	code := generateMockFuncExpectFuncMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// ExpectFunc registers an expectation that the Dof method of the parent
+		// MockTestClient instance is invoked once with arguments for which the
+		// given function returns true. The function is invoked in the same way as
+		// the function given to WhenFunc. Expectations are checked before
+		// conditions and the hook queue; matching invocations without an
+		// expectation hook fall through to them. Unmet and over-satisfied
+		// expectations and unexpected invocations are reported by
+		// AssertExpectations.
+		func (f *TestClientDofFunc) ExpectFunc(match func(string, ...string) bool) *TestClientDofFuncExpectation {
+			return f.expect([]interface{}{match}, match)
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncExpectHelperMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.expectations = true
+	code := generateMockFuncExpectHelperMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		func (f *TestClientDoFunc) expect(args []interface{}, match func(string) bool) *TestClientDoFuncExpectation {
+			expectation := &TestClientDoFuncExpectation{
+				f:     f,
+				args:  args,
+				match: match,
+				times: 1,
+			}
+
+			f.mutex.Lock()
+			f.expectations = append(f.expectations, expectation)
+			f.mutex.Unlock()
+
+			return expectation
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncPendingHooksMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.pendingHooks = true
+	code := generateMockFuncPendingHooksMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// PendingHooks returns the number of pushed hooks and return values that
+		// have not yet been invoked.
+		func (f *TestClientDoFunc) PendingHooks() int {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			return len(f.hooks)
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncClearHistoryMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.resetMethods = true
+	wrappedInterface.callInfoHooks = true
+	code := generateMockFuncClearHistoryMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// ClearHistory discards the record of all previous invocations of this
+		// function.
+		func (f *TestClientDoFunc) ClearHistory() {
+			f.mutex.Lock()
+			f.cleared += len(f.history)
+			f.history = nil
+			f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncClearHooksMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.resetMethods = true
+	wrappedInterface.callInfoHooks = true
+	code := generateMockFuncClearHooksMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// ClearHooks discards all hooks and return values that have been pushed
+		// onto the hook queue but not yet invoked.
+		func (f *TestClientDoFunc) ClearHooks() {
+			f.mutex.Lock()
+			f.hooks = nil
+			f.callHooks = nil
+			f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncResetMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.resetMethods = true
+	wrappedInterface.callInfoHooks = true
+	code := generateMockFuncResetMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Reset restores this function to the state in which it was constructed.
+		// The default hook set by the constructor is restored, and all queued hooks
+		// and recorded invocations are discarded.
+		func (f *TestClientDoFunc) Reset() {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			if f.initialHookSaved {
+				f.defaultHook = f.initialHook
+			}
+			f.cleared += len(f.history)
+			f.hooks = nil
+			f.defaultCallHook = nil
+			f.callHooks = nil
+			f.history = nil
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncWaitForCallsMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.subscriptions = true
+	code := generateMockFuncWaitForCallsMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// WaitForCalls blocks until this function has been invoked at least n times
+		// or the given context is canceled. The context error is returned if the
+		// context is canceled first.
+		func (f *TestClientDoFunc) WaitForCalls(ctx context.Context, n int) error {
+			for {
+				f.mutex.Lock()
+				if len(f.history) >= n {
+					f.mutex.Unlock()
+					return nil
+				}
+				if f.callSignal == nil {
+					f.callSignal = make(chan struct{})
+				}
+				signal := f.callSignal
+				f.mutex.Unlock()
+
+				select {
+				case <-signal:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncCallsMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.subscriptions = true
+	code := generateMockFuncCallsMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Calls returns a channel that receives a TestClientDoFuncCall object for
+		// each invocation of this function made after this call, until the given
+		// context is canceled. Invocations never block on the channel: values are
+		// buffered until they are received. Once the context is canceled, buffered
+		// values are discarded and the channel is closed.
+		func (f *TestClientDoFunc) Calls(ctx context.Context) <-chan TestClientDoFuncCall {
+			return f.subscriptions.Subscribe(ctx)
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncSetErrorMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	wrappedInterface.errorHelpers = true
+	code := generateMockFuncSetErrorMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// SetDefaultError calls SetDefaultHook with a function that returns the
+		// given error and zero values for all other results.
+		func (f *TestClientFetchFunc) SetDefaultError(err error) {
+			f.SetDefaultHook(func(string) (r0 string, r1 error) {
+				return r0, err
+			})
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncPushErrorMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodClose)
+	wrappedInterface.errorHelpers = true
+	code := generateMockFuncPushErrorMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// PushError calls PushHook with a function that returns the given error and
+		// zero values for all other results.
+		func (f *TestClientCloseFunc) PushError(err error) {
+			f.PushHook(func() (r0 error) {
+				return err
+			})
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncPushErrorNMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	wrappedInterface.errorHelpers = true
+	code := generateMockFuncPushErrorNMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// PushErrorN calls PushError with the given error n times.
+		func (f *TestClientFetchFunc) PushErrorN(n int, err error) {
+			for i := 0; i < n; i++ {
+				f.PushError(err)
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncErrorMethodsWithoutErrorResult(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.errorHelpers = true
+	wrappedInterface.faultInjection = true
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncSetErrorMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncPushErrorMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncPushErrorNMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncInjectErrorMethod(wrappedInterface, wrappedMethod, "")))
+}
+
+func TestGenerateMockFuncPushHookNMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.returnSequences = true
+	code := generateMockFuncPushHookNMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// PushHookN adds the given function to the end of the hook queue n times.
+		func (f *TestClientDoFunc) PushHookN(n int, hook func(string) bool) {
+			f.mutex.Lock()
+			for i := 0; i < n; i++ {
+				f.hooks = append(f.hooks, hook)
+			}
+			f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncPushReturnNMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.returnSequences = true
+	code := generateMockFuncPushReturnNMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// PushReturnN calls PushReturn with the given values n times.
+		func (f *TestClientDoFunc) PushReturnN(n int, r0 bool) {
+			for i := 0; i < n; i++ {
+				f.PushReturn(r0)
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncSetReturnSequenceMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.returnSequences = true
+	code := generateMockFuncSetReturnSequenceMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// SetReturnSequence replaces the hook queue with one hook for each of the
+		// given results, in order. If repeatLast is true, the final results are
+		// also returned by every invocation after the queue is empty. Otherwise,
+		// the default hook function is invoked once the sequence is exhausted.
+		func (f *TestClientDoFunc) SetReturnSequence(repeatLast bool, results ...TestClientDoResults) {
+			hooks := make([]func(string) bool, 0, len(results))
+			for _, r := range results {
+				r := r
+				hooks = append(hooks, func(string) bool {
+					return r.Result0
+				})
+			}
+
+			f.mutex.Lock()
+			f.hooks = hooks
+			f.callHooks = nil
+			f.mutex.Unlock()
+
+			if repeatLast && len(hooks) > 0 {
+				f.SetDefaultHook(hooks[len(hooks)-1])
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncSetHookWithCallMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.resetMethods = true
+	wrappedInterface.callInfoHooks = true
+	code := generateMockFuncSetHookWithCallMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// SetDefaultHookWithCall is like SetDefaultHook, but the given function
+		// also receives a TestClientCallInfo describing the invocation.
+		func (f *TestClientDoFunc) SetDefaultHookWithCall(hook func(TestClientCallInfo, string) bool) {
+			f.mutex.Lock()
+			if !f.initialHookSaved {
+				f.initialHook = f.defaultHook
+				f.initialHookSaved = true
+			}
+			f.defaultCallHook = hook
+			f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncPushHookWithCallMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.callInfoHooks = true
+	code := generateMockFuncPushHookWithCallMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// PushHookWithCall is like PushHook, but the given function also receives a
+		// TestClientCallInfo describing the invocation.
+		func (f *TestClientDoFunc) PushHookWithCall(hook func(TestClientCallInfo, string) bool) {
+			f.mutex.Lock()
+			f.hooks = append(f.hooks, nil)
+			f.callHooks = append(f.callHooks, hook)
+			f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncBindCallHookMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.callInfoHooks = true
+	code := generateMockFuncBindCallHookMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		func (f *TestClientDoFunc) bindCallHook(hook func(TestClientCallInfo, string) bool, info TestClientCallInfo) func(string) bool {
+			return func(v0 string) bool {
+				return hook(info, v0)
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncBindCallHookMethodVariadic(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDof)
+	wrappedInterface.callInfoHooks = true
+	code := generateMockFuncBindCallHookMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		func (f *TestClientDofFunc) bindCallHook(hook func(TestClientCallInfo, string, ...string) bool, info TestClientCallInfo) func(string, ...string) bool {
+			return func(v0 string, v1 ...string) bool {
+				return hook(info, v0, v1...)
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncPushHookWithCallMethodParameterNames(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodGet)
+	wrappedInterface.callInfoHooks = true
+	wrappedMethod.useParameterNames(nil)
+	code := generateMockFuncPushHookWithCallMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// PushHookWithCall is like PushHook, but the given function also receives a
+		// TestClientCallInfo describing the invocation.
+		func (f *TestClientGetFunc) PushHookWithCall(hook func(info TestClientCallInfo, key string, v1 string, v2 string, args bool) bool) {
+			f.mutex.Lock()
+			f.hooks = append(f.hooks, nil)
+			f.callHooks = append(f.callHooks, hook)
+			f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncRecoverCallMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.recordPanics = true
+	code := generateMockFuncRecoverCallMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		func (f *TestClientDoFunc) recoverCall(r0 TestClientDoFuncCall) {
+			if p := recover(); p != nil {
+				r0.panicValue = p
+				f.appendCall(r0)
+				panic(p)
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncNextHookMethodErrorResult(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	wrappedInterface.expectations = true
+	wrappedInterface.faultInjection = true
+	code := generateMockFuncNextHookMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		func (f *TestClientFetchFunc) nextHook(fault mocksupport.Fault, v0 string) func(string) (string, error) {
+			args := []interface{}{v0}
+
+			f.mutex.Lock()
+			expectations := f.expectations
+			f.mutex.Unlock()
+
+			var matches []*TestClientFetchFuncExpectation
+			for _, expectation := range expectations {
+				if expectation.match(v0) {
+					matches = append(matches, expectation)
+				}
+			}
+
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			expectation := f.claimExpectation(expectations, matches, args)
+			if fault.Err != nil {
+				return func(string) (r0 string, r1 error) {
+					return r0, fault.Err
+				}
+			}
+			if expectation != nil && expectation.hook != nil {
+				return expectation.hook
+			}
+			if len(f.hooks) == 0 {
+				return f.defaultHook
+			}
+
+			hook := f.hooks[0]
+			f.hooks = f.hooks[1:]
+			return hook
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncInjectErrorMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	wrappedInterface.expectations = true
+	wrappedInterface.faultInjection = true
+	code := generateMockFuncInjectErrorMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// InjectError causes the given fraction of subsequent invocations to return
+		// the given error and zero values for all other results without invoking
+		// any hook. Injected errors are drawn from a source seeded via SetFaultSeed
+		// and are recorded in the invocation history. An invocation answered by an
+		// injected error is still matched against and counted by expectations, but
+		// never invokes the expectation's hook. A rate of zero or a nil error
+		// disables error injection.
+		func (f *TestClientFetchFunc) InjectError(rate float64, err error) {
+			f.faults.SetError(rate, err)
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncInjectLatencyMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.faultInjection = true
+	code := generateMockFuncInjectLatencyMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// InjectLatency delays each subsequent invocation by the given duration
+		// before it is handled. The injected latency is recorded in the invocation
+		// history.
+		func (f *TestClientDoFunc) InjectLatency(d time.Duration) {
+			f.faults.SetLatency(d)
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncSetFaultSeedMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.faultInjection = true
+	code := generateMockFuncSetFaultSeedMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// SetFaultSeed seeds the source from which injected faults are drawn. The
+		// source is seeded with zero by default.
+		func (f *TestClientDoFunc) SetFaultSeed(seed int64) {
+			f.faults.Seed(seed)
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncPushBlockingHookMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	wrappedInterface.blockingHooks = true
+	code := generateMockFuncPushBlockingHookMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// PushBlockingHook adds a hook to the end of the hook queue that blocks the
+		// invocation handling it until the returned gate is released or failed. The
+		// gate reports when the invocation begins blocking, which allows a test to
+		// inspect intermediate state while the code under test is paused inside the
+		// invocation.
+		func (f *TestClientFetchFunc) PushBlockingHook() *TestClientFetchFuncGate {
+			gate := &TestClientFetchFuncGate{entered: make(chan struct{}), results: make(chan TestClientFetchResults, 1)}
+			f.PushHook(func(string) (string, error) {
+				close(gate.entered)
+				r := <-gate.results
+				return r.Result0, r.Result1
+			})
+			return gate
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncBeginCallMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.trackConcurrency = true
+	code := generateMockFuncBeginCallMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		func (f *TestClientDoFunc) beginCall() {
+			f.mutex.Lock()
+			f.inFlight++
+			if f.inFlight > f.maxInFlight {
+				f.maxInFlight = f.inFlight
+			}
+			f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncEndCallMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.trackConcurrency = true
+	code := generateMockFuncEndCallMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		func (f *TestClientDoFunc) endCall() {
+			f.mutex.Lock()
+			f.inFlight--
+			f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncInFlightMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.trackConcurrency = true
+	code := generateMockFuncInFlightMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// InFlight returns the number of invocations of this function that have
+		// begun but not yet returned.
+		func (f *TestClientDoFunc) InFlight() int {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			return f.inFlight
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncMaxConcurrencyMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.trackConcurrency = true
+	code := generateMockFuncMaxConcurrencyMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// MaxConcurrency returns the maximum number of invocations of this function
+		// that were in flight at the same time.
+		func (f *TestClientDoFunc) MaxConcurrency() int {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			return f.maxInFlight
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncResetMethodTrackConcurrency(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.trackConcurrency = true
+	wrappedInterface.resetMethods = true
+	code := generateMockFuncResetMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Reset restores this function to the state in which it was constructed.
+		// The default hook set by the constructor is restored, and all queued hooks
+		// and recorded invocations are discarded.
+		func (f *TestClientDoFunc) Reset() {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			if f.initialHookSaved {
+				f.defaultHook = f.initialHook
+			}
+			f.hooks = nil
+			f.history = nil
+			f.maxInFlight = f.inFlight
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncConcurrencyMethodsDisabled(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncBeginCallMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncEndCallMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncInFlightMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncMaxConcurrencyMethod(wrappedInterface, wrappedMethod, "")))
+}
+
+func TestGenerateMockFuncResultMockMethods(t *testing.T) {
+	wrappedInterface := makeRecursiveInterface(TestMethodChild, TestMethodWith)
+	code := generateMockFuncResultMockMethods(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		// Result0Mock returns the mock returned as the 1st result of invocations of
+		// this function that are not handled by a hook. The mock is created on
+		// first use.
+		func (f *TestClientChildFunc) Result0Mock() *MockTestChild {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			if f.result0Mock == nil {
+				f.result0Mock = NewMockTestChild()
+			}
+			return f.result0Mock
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncNextHookMethodRecursiveMocks(t *testing.T) {
+	wrappedInterface := makeRecursiveInterface(TestMethodChild, TestMethodWith)
+	code := generateMockFuncNextHookMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		func (f *TestClientChildFunc) nextHook() func(string) (test.Child, error) {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			if len(f.hooks) == 0 {
+				if f.defaultHook == nil {
+					return func(string) (r0 test.Child, r1 error) {
+						r0 = f.Result0Mock()
+						return
+					}
+				}
+				return f.defaultHook
+			}
+
+			hook := f.hooks[0]
+			f.hooks = f.hooks[1:]
+			return hook
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncNextHookMethodRecursiveSelf(t *testing.T) {
+	wrappedInterface := makeRecursiveInterface(TestMethodChild, TestMethodWith)
+	code := generateMockFuncNextHookMethod(wrappedInterface, wrappedInterface.wrappedMethods[1], "")
+	expected := strip(`
+		func (f *TestClientWithFunc) nextHook(mock *MockTestClient) func(string) test.Client {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			if len(f.hooks) == 0 {
+				if f.defaultHook == nil {
+					return func(string) (r0 test.Client) {
+						r0 = mock
+						return
+					}
+				}
+				return f.defaultHook
+			}
+
+			hook := f.hooks[0]
+			f.hooks = f.hooks[1:]
+			return hook
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncResetMethodRecursiveMocks(t *testing.T) {
+	wrappedInterface := makeRecursiveInterface(TestMethodChild, TestMethodWith)
+	wrappedInterface.resetMethods = true
+	code := generateMockFuncResetMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		// Reset restores this function to the state in which it was constructed.
+		// The default hook set by the constructor is restored, and all queued hooks
+		// and recorded invocations are discarded.
+		func (f *TestClientChildFunc) Reset() {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			if f.initialHookSaved {
+				f.defaultHook = f.initialHook
+			}
+			f.hooks = nil
+			f.history = nil
+			f.result0Mock = nil
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncNextHookMethodRecursiveMocksDefaultValues(t *testing.T) {
+	wrappedInterface := makeRecursiveInterface(TestMethodChild, TestMethodWith)
+	wrappedInterface.defaults = normalizeDefaults(map[string]string{"error": "errors.New(\"unstubbed\")"})
+	code := generateMockFuncNextHookMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		func (f *TestClientChildFunc) nextHook() func(string) (test.Child, error) {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			if len(f.hooks) == 0 {
+				if f.defaultHook == nil {
+					return func(string) (r0 test.Child, r1 error) {
+						r0 = f.Result0Mock()
+						r1 = errors.New("unstubbed")
+						return
+					}
+				}
+				return f.defaultHook
+			}
+
+			hook := f.hooks[0]
+			f.hooks = f.hooks[1:]
+			return hook
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncNextHookMethodDefaultValues(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodList)
+	wrappedInterface.emptyCollections = true
+	wrappedInterface.zeroValueMocks = true
+	code := generateMockFuncNextHookMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		func (f *TestClientListFunc) nextHook() func(string) ([]string, map[string]bool, time.Time, error) {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			if len(f.hooks) == 0 {
+				if f.defaultHook == nil {
+					return func(string) (r0 []string, r1 map[string]bool, r2 time.Time, r3 error) {
+						r0 = []string{}
+						r1 = map[string]bool{}
+						return
+					}
+				}
+				return f.defaultHook
+			}
+
+			hook := f.hooks[0]
+			f.hooks = f.hooks[1:]
+			return hook
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncResetMethodOptInFeatures(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.expectations = true
+	wrappedInterface.faultInjection = true
+	wrappedInterface.conditions = true
+	wrappedInterface.resetMethods = true
+	wrappedInterface.callInfoHooks = true
+	code := generateMockFuncResetMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Reset restores this function to the state in which it was constructed.
+		// The default hook set by the constructor is restored, all queued hooks,
+		// conditions, expectations, and recorded invocations are discarded, and
+		// fault injection is disabled.
+		func (f *TestClientDoFunc) Reset() {
+			f.mutex.Lock()
+			defer f.mutex.Unlock()
+
+			if f.initialHookSaved {
+				f.defaultHook = f.initialHook
+			}
+			f.cleared += len(f.history)
+			f.hooks = nil
+			f.defaultCallHook = nil
+			f.callHooks = nil
+			f.conditions = nil
+			f.expectations = nil
+			f.unexpected = nil
+			f.history = nil
+			f.faults.Reset()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncOptInMethodsDisabled(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncWhenMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncWhenFuncMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncExpectMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncExpectFuncMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncExpectHelperMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncClaimExpectationMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncPushHookNMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncPushBlockingHookMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncPushReturnNMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncSetReturnSequenceMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncSetErrorMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncPushErrorMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncPushErrorNMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncExpectationFailuresMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncInjectErrorMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncInjectLatencyMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncSetFaultSeedMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncWaitForCallsMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncCallsMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncPendingHooksMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncClearHistoryMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncClearHooksMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncResetMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncSetHookWithCallMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncPushHookWithCallMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncReserveCallMethod(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncBindCallHookMethod(wrappedInterface, wrappedMethod, "")))
+}
+
+func TestGenerateMockFuncAppendCallMethodSubscriptions(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.subscriptions = true
+	code := generateMockFuncAppendCallMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		func (f *TestClientDoFunc) appendCall(r0 TestClientDoFuncCall) {
+			f.mutex.Lock()
+			f.history = append(f.history, r0)
+			if f.callSignal != nil {
+				close(f.callSignal)
+				f.callSignal = nil
+			}
+			f.subscriptions.Publish(r0)
+			f.mutex.Unlock()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
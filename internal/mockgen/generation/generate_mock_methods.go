@@ -2,6 +2,7 @@ package generation
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/dave/jennifer/jen"
 )
@@ -17,7 +18,7 @@ func generateMockInterfaceMethod(iface *wrappedInterface, method *wrappedMethod,
 	paramNames := make([]jen.Code, 0, len(method.Params))
 	argumentExpressions := make([]jen.Code, 0, len(method.Params))
 	for i := 0; i < len(method.Params); i++ {
-		name := fmt.Sprintf("v%d", i)
+		name := method.paramNames[i]
 
 		nameExpression := jen.Id(name)
 		if method.Variadic && i == len(method.Params)-1 {
@@ -33,9 +34,89 @@ func generateMockInterfaceMethod(iface *wrappedInterface, method *wrappedMethod,
 		resultNames = append(resultNames, jen.Id(fmt.Sprintf("r%d", i)))
 	}
 
-	functionExpression := jen.Id("m").Dot(mockFuncFieldName).Dot("nextHook").Call()
+	var nextHookArgs []jen.Code
+	if iface.callInfoHooks {
+		nextHookArgs = append(nextHookArgs, jen.Id("info"))
+	} else if iface.returnsSelf(method) {
+		nextHookArgs = append(nextHookArgs, jen.Id("m"))
+	}
+	if iface.injectsErrors(method) {
+		nextHookArgs = append(nextHookArgs, jen.Id("fault"))
+	}
+	var faultStatement jen.Code = jen.Null()
+	if iface.faultInjection {
+		faultStatement = jen.Id("fault").Op(":=").Id("m").Dot(mockFuncFieldName).Dot("faults").Dot("Next").Call()
+	}
+
+	var trackStatements []jen.Code
+	if iface.trackConcurrency {
+		trackStatements = append(trackStatements,
+			jen.Id("m").Dot(mockFuncFieldName).Dot("beginCall").Call(),
+			jen.Defer().Id("m").Dot(mockFuncFieldName).Dot("endCall").Call(),
+		)
+	}
+	if iface.matchesArguments() {
+		nextHookArgs = append(nextHookArgs, paramNames...)
+	}
+	functionExpression := jen.Id("m").Dot(mockFuncFieldName).Dot("nextHook").Call(nextHookArgs...)
 	callStatement := functionExpression.Call(argumentExpressions...)
-	callInstanceExpression := compose(addTypes(jen.Id(mockFuncCallStructName), iface.TypeParams, outputImportPath, false), jen.Values(append(paramNames, resultNames...)...))
+	argFieldValues := make([]jen.Code, 0, len(paramNames))
+	resultFieldValues := make([]jen.Code, 0, len(resultNames))
+	keyField := func(name string, value jen.Code) jen.Code {
+		if !iface.keyedCalls() {
+			return value
+		}
+
+		return jen.Id(name).Op(":").Add(value)
+	}
+	copyStatements := make([]jen.Code, 0, len(paramNames))
+	for i, paramName := range paramNames {
+		if iface.deepCopyArguments && isDeepCopied(method.Params[i]) {
+			copyName := fmt.Sprintf("a%d", i)
+			copyStatements = append(copyStatements, jen.Id(copyName).Op(":=").Qual(supportImportPath, "DeepCopy").Call(paramName))
+			paramName = jen.Id(copyName)
+		}
+
+		argFieldValues = append(argFieldValues, keyField(method.argFieldNames[i], paramName))
+	}
+	for i, resultName := range resultNames {
+		resultFieldValues = append(resultFieldValues, keyField(fmt.Sprintf("Result%d", i), resultName))
+	}
+
+	var captureStatement jen.Code = jen.Null()
+	var metadataFieldValues []jen.Code
+	if iface.recordCallMetadata {
+		captureStatement = jen.Id("metadata").Op(":=").Qual(supportImportPath, "CaptureCallMetadata").Call()
+		metadataFieldValues = append(metadataFieldValues, jen.Id("metadata").Op(":").Id("metadata"))
+	}
+
+	var sequenceStatement jen.Code = jen.Null()
+	if iface.callSequence {
+		sequenceStatement = jen.Id("sequence").Op(":=").Qual(supportImportPath, "NextSequence").Call()
+		metadataFieldValues = append(metadataFieldValues, jen.Id("sequence").Op(":").Id("sequence"))
+	}
+
+	callInstanceType := addTypes(jen.Id(mockFuncCallStructName), iface.TypeParams, outputImportPath, false)
+	var reserveStatement jen.Code = jen.Null()
+	if iface.callInfoHooks {
+		// The history entry is reserved before the hook is invoked so that the index given
+		// to call hooks is the position at which the invocation is recorded
+		reservedCallInstanceExpression := compose(callInstanceType, jen.Values(append(append([]jen.Code(nil), argFieldValues...), metadataFieldValues...)...))
+		reserveStatement = jen.Id("info").Op(":=").Id("m").Dot(mockFuncFieldName).Dot("reserveCall").Call(jen.Id("m"), reservedCallInstanceExpression)
+		metadataFieldValues = append(metadataFieldValues, jen.Id("slot").Op(":").Id("info").Dot("slot"))
+	}
+	var recoverFuncCall jen.Code = jen.Null()
+	if iface.recordPanics {
+		panickedFieldValues := append(append([]jen.Code(nil), argFieldValues...), metadataFieldValues...)
+		panickedCallInstanceExpression := compose(callInstanceType, jen.Values(panickedFieldValues...))
+		recoverFuncCall = jen.Defer().Id("m").Dot(mockFuncFieldName).Dot("recoverCall").Call(panickedCallInstanceExpression)
+	}
+
+	fieldValues := append(append(argFieldValues, resultFieldValues...), metadataFieldValues...)
+	if iface.faultInjection {
+		fieldValues = append(fieldValues, jen.Id("fault").Op(":").Id("fault"))
+	}
+	callInstanceExpression := compose(callInstanceType, jen.Values(fieldValues...))
 	appendFuncCall := jen.Id("m").Dot(mockFuncFieldName).Dot("appendCall").Call(callInstanceExpression)
 	returnStatement := jen.Return()
 
@@ -51,11 +132,18 @@ func generateMockInterfaceMethod(iface *wrappedInterface, method *wrappedMethod,
 		callStatement = compose(assignmentTarget.Op(":="), callStatement)
 	}
 
-	return generateMockMethod(iface, method, commentText, outputImportPath,
-		callStatement,   // r<n>, ... := m.<MethodName>Func.nextHook()(Param<n>, ...)
-		appendFuncCall,  // m.<MethodName>Func.appendCall(<InterfaceName><MethodName>FuncCall{Param<n>, ..., r<n>, ...})
-		returnStatement, // return r<n>, ...
-	)
+	body := []jen.Code{generateInitFuncsStatement(iface)} // m.initFuncs() (if enabled)
+	body = append(body, sequenceStatement)                // sequence := mocksupport.NextSequence() (if enabled)
+	body = append(body, captureStatement)                 // metadata := mocksupport.CaptureCallMetadata() (if enabled)
+	body = append(body, copyStatements...)                // a<n> := mocksupport.DeepCopy(Param<n>), ... (if enabled)
+	body = append(body, reserveStatement)                 // info := m.<MethodName>Func.reserveCall(m, <InterfaceName><MethodName>FuncCall{Arg<n>: Param<n>, ...}) (if enabled)
+	body = append(body, recoverFuncCall)                  // defer m.<MethodName>Func.recoverCall(<InterfaceName><MethodName>FuncCall{Arg<n>: Param<n>, ..., [sequence: sequence, ][slot: info.slot]}) (if enabled)
+	body = append(body, faultStatement)                   // fault := m.<MethodName>Func.faults.Next() (if enabled)
+	body = append(body, trackStatements...)               // m.<MethodName>Func.beginCall(); defer m.<MethodName>Func.endCall() (if enabled)
+	body = append(body, callStatement)                    // r<n>, ... := m.<MethodName>Func.nextHook([info|m, ][fault, ][Param<n>, ...])(Param<n>, ...)
+	body = append(body, appendFuncCall)                   // m.<MethodName>Func.appendCall(<InterfaceName><MethodName>FuncCall{[Arg<n>: ]Param<n>, ..., [Result<n>: ]r<n>, ..., [sequence: sequence, ][slot: info.slot, ][fault: fault]})
+	body = append(body, returnStatement)                  // return r<n>, ...
+	return generateMockMethod(iface, method, commentText, outputImportPath, body...)
 }
 
 func generateMockMethod(
@@ -67,10 +155,182 @@ func generateMockMethod(
 ) jen.Code {
 	params := make([]jen.Code, 0, len(method.paramTypes))
 	for i, param := range method.paramTypes {
-		params = append(params, compose(jen.Id(fmt.Sprintf("v%d", i)), param))
+		params = append(params, compose(jen.Id(method.paramNames[i]), param))
 	}
 
 	receiver := compose(jen.Id("m").Op("*"), addTypes(jen.Id(iface.mockStructName), iface.TypeParams, outputImportPath, false))
 	methodDeclaration := jen.Func().Params(receiver).Id(method.Name).Params(params...).Params(method.resultTypes...).Block(body...)
 	return addComment(methodDeclaration, 1, commentText)
 }
+
+func generateMockAssertExpectationsMethod(iface *wrappedInterface, outputImportPath string) jen.Code {
+	if !iface.expectations {
+		return jen.Null()
+	}
+
+	commentText := strings.Join([]string{
+		`AssertExpectations reports all unmet and over-satisfied expectations and all unexpected invocations of the methods of this mock as a single failure via the given test value.`,
+		`An invocation is unexpected if the method has expectations but none of them match its arguments.`,
+		`The return value is true if all expectations were met.`,
+	}, " ")
+
+	failuresDeclaration := jen.Var().Id("failures").Index().String()
+	appendStatements := make([]jen.Code, 0, len(iface.wrappedMethods))
+	for _, method := range iface.wrappedMethods {
+		mockFuncFieldName := fmt.Sprintf("%sFunc", method.Name)
+		failuresExpression := jen.Id("m").Dot(mockFuncFieldName).Dot("expectationFailures").Call()
+		appendStatements = append(appendStatements, jen.Id("failures").Op("=").Append(jen.Id("failures"), failuresExpression.Op("...")))
+	}
+	message := jen.Lit(fmt.Sprintf("%s expectations were not met", iface.mockStructName))
+	reportExpression := jen.Qual(supportImportPath, "ReportFailures").Call(jen.Id("t"), message, jen.Id("failures"))
+	returnStatement := jen.Return(reportExpression)
+
+	body := []jen.Code{generateInitFuncsStatement(iface)} // m.initFuncs() (if enabled and the interface has methods)
+	body = append(body, failuresDeclaration)              // var failures []string
+	body = append(body, appendStatements...)              // failures = append(failures, m.<MethodName>Func.expectationFailures()...)
+	body = append(body, returnStatement)                  // return mocksupport.ReportFailures(t, "<Struct> expectations were not met", failures)
+
+	params := []jen.Code{jen.Id("t").Qual(supportImportPath, "TestingT")}
+	results := []jen.Code{jen.Bool()}
+	return generateMockStructMethod(iface, outputImportPath, "AssertExpectations", commentText, params, results, body...)
+}
+
+func generateMockAssertAllHooksConsumedMethod(iface *wrappedInterface, outputImportPath string) jen.Code {
+	if !iface.pendingHooks {
+		return jen.Null()
+	}
+
+	commentText := strings.Join([]string{
+		`AssertAllHooksConsumed reports all methods of this mock with pushed hooks or return values that were never invoked as a single failure via the given test value.`,
+		`The return value is true if all hook queues are empty.`,
+	}, " ")
+
+	failuresDeclaration := jen.Var().Id("failures").Index().String()
+	checkStatements := make([]jen.Code, 0, len(iface.wrappedMethods))
+	for _, method := range iface.wrappedMethods {
+		mockFuncFieldName := fmt.Sprintf("%sFunc", method.Name)
+		name := jen.Lit(fmt.Sprintf("%s.%s", iface.mockStructName, method.Name))
+		pendingCondition := jen.Id("n").Op(":=").Id("m").Dot(mockFuncFieldName).Dot("PendingHooks").Call().Op(";").Id("n").Op("!=").Lit(0)
+		failureExpression := jen.Qual(supportImportPath, "PendingHooksFailure").Call(name, jen.Id("n"))
+		checkStatements = append(checkStatements, jen.If(pendingCondition).Block(selfAppend(jen.Id("failures"), failureExpression)))
+	}
+	message := jen.Lit(fmt.Sprintf("%s hooks were not consumed", iface.mockStructName))
+	reportExpression := jen.Qual(supportImportPath, "ReportFailures").Call(jen.Id("t"), message, jen.Id("failures"))
+	returnStatement := jen.Return(reportExpression)
+
+	body := []jen.Code{generateInitFuncsStatement(iface)} // m.initFuncs() (if enabled and the interface has methods)
+	body = append(body, failuresDeclaration)              // var failures []string
+	body = append(body, checkStatements...)               // if n := m.<MethodName>Func.PendingHooks(); n != 0 { failures = append(failures, mocksupport.PendingHooksFailure("<Struct>.<MethodName>", n)) }
+	body = append(body, returnStatement)                  // return mocksupport.ReportFailures(t, "<Struct> hooks were not consumed", failures)
+
+	params := []jen.Code{jen.Id("t").Qual(supportImportPath, "TestingT")}
+	results := []jen.Code{jen.Bool()}
+	return generateMockStructMethod(iface, outputImportPath, "AssertAllHooksConsumed", commentText, params, results, body...)
+}
+
+func generateMockResetMethod(iface *wrappedInterface, outputImportPath string) jen.Code {
+	if !iface.resetMethods {
+		return jen.Null()
+	}
+
+	methodName := iface.resetMethodName()
+	commentText := strings.Join([]string{
+		fmt.Sprintf(`%s restores all methods of this mock to the state in which they were constructed.`, methodName),
+		fmt.Sprintf(`The default hooks set by the constructor are restored, and %s are discarded.`, resetStateText(iface)),
+	}, " ")
+
+	body := make([]jen.Code, 0, len(iface.wrappedMethods)+1)
+	body = append(body, generateInitFuncsStatement(iface)) // m.initFuncs() (if enabled and the interface has methods)
+	for _, method := range iface.wrappedMethods {
+		mockFuncFieldName := fmt.Sprintf("%sFunc", method.Name)
+		body = append(body, jen.Id("m").Dot(mockFuncFieldName).Dot("Reset").Call()) // m.<MethodName>Func.Reset()
+	}
+
+	return generateMockStructMethod(iface, outputImportPath, methodName, commentText, nil, nil, body...)
+}
+
+// resetStateText describes the state of a mock function that is discarded by Reset.
+func resetStateText(iface *wrappedInterface) string {
+	state := []string{"all queued hooks"}
+	if iface.conditions {
+		state = append(state, "conditions")
+	}
+	if iface.expectations {
+		state = append(state, "expectations")
+	}
+	state = append(state, "recorded invocations")
+
+	if len(state) == 2 {
+		return strings.Join(state, " and ")
+	}
+	return strings.Join(state[:len(state)-1], ", ") + ", and " + state[len(state)-1]
+}
+
+func generateMockInitFuncsMethod(iface *wrappedInterface, outputImportPath string) jen.Code {
+	if !iface.initsFuncs() {
+		return jen.Null()
+	}
+
+	commentText := strings.Join([]string{
+		`initFuncs replaces each nil mock function object of this mock with one that returns zero values for all results.`,
+		`This allows a zero-value mock to be used in place of one returned by a constructor.`,
+	}, " ")
+
+	lockStatement := jen.Id("m").Dot("mutex").Dot("Lock").Call()
+	deferUnlockStatement := jen.Defer().Id("m").Dot("mutex").Dot("Unlock").Call()
+
+	initStatements := make([]jen.Code, 0, len(iface.wrappedMethods))
+	for _, method := range iface.wrappedMethods {
+		mockFuncFieldName := fmt.Sprintf("%sFunc", method.Name)
+		mockFuncStructName := fmt.Sprintf("%s%s%sFunc", iface.prefix, iface.titleName, method.Name)
+		mockFuncExpression := compose(jen.Op("&"), addTypes(jen.Id(mockFuncStructName), iface.TypeParams, outputImportPath, false)).Values()
+		assignStatement := jen.Id("m").Dot(mockFuncFieldName).Op("=").Add(mockFuncExpression)
+		initStatements = append(initStatements, jen.If(jen.Id("m").Dot(mockFuncFieldName).Op("==").Nil()).Block(assignStatement))
+	}
+
+	body := []jen.Code{lockStatement}                     // m.mutex.Lock()
+	body = append(body, deferUnlockStatement, jen.Line()) // defer m.mutex.Unlock()
+	body = append(body, initStatements...)                // if m.<MethodName>Func == nil { m.<MethodName>Func = &<prefix>Func{} }
+	return generateMockStructMethod(iface, outputImportPath, "initFuncs", commentText, nil, nil, body...)
+}
+
+func generateMockFuncAccessorMethod(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.zeroValueMocks {
+		return jen.Null()
+	}
+
+	mockFuncFieldName := fmt.Sprintf("%sFunc", method.Name)
+	mockFuncStructName := fmt.Sprintf("%s%s%sFunc", iface.prefix, iface.titleName, method.Name)
+	commentText := strings.Join([]string{
+		fmt.Sprintf(`Get%s returns the mock function object of the %s method.`, mockFuncFieldName, method.Name),
+		`The mock function objects of a zero-value mock are created on first use, so this method allows a zero-value mock to be configured before any of its methods are invoked.`,
+	}, " ")
+
+	returnStatement := jen.Return(jen.Id("m").Dot(mockFuncFieldName))
+	results := []jen.Code{compose(jen.Op("*"), addTypes(jen.Id(mockFuncStructName), iface.TypeParams, outputImportPath, false))}
+
+	body := []jen.Code{generateInitFuncsStatement(iface)} // m.initFuncs()
+	body = append(body, returnStatement)                  // return m.<MethodName>Func
+	return generateMockStructMethod(iface, outputImportPath, "Get"+mockFuncFieldName, commentText, nil, results, body...)
+}
+
+func generateInitFuncsStatement(iface *wrappedInterface) jen.Code {
+	if !iface.initsFuncs() {
+		return jen.Null()
+	}
+
+	return jen.Id("m").Dot("initFuncs").Call()
+}
+
+func generateMockStructMethod(
+	iface *wrappedInterface,
+	outputImportPath string,
+	methodName string,
+	commentText string,
+	params, results []jen.Code,
+	body ...jen.Code,
+) jen.Code {
+	receiver := compose(jen.Id("m").Op("*"), addTypes(jen.Id(iface.mockStructName), iface.TypeParams, outputImportPath, false))
+	methodDeclaration := jen.Func().Params(receiver).Id(methodName).Params(params...).Params(results...).Block(body...)
+	return addComment(methodDeclaration, 1, commentText)
+}
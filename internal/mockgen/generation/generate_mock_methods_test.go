@@ -9,13 +9,38 @@ import (
 
 func TestGenerateMockInterfaceMethod(t *testing.T) {
 	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.callSequence = true
+	wrappedInterface.callInfoHooks = true
+	wrappedInterface.recordPanics = true
+	wrappedInterface.zeroValueMocks = true
 	code := generateMockInterfaceMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
 	expected := strip(`
 		// Do delegates to the next hook function in the queue and stores the
 		// parameter and result values of this invocation.
 		func (m *MockTestClient) Do(v0 string) bool {
+			m.initFuncs()
+			sequence := mocksupport.NextSequence()
+			info := m.DoFunc.reserveCall(m, TestClientDoFuncCall{Arg0: v0, sequence: sequence})
+			defer m.DoFunc.recoverCall(TestClientDoFuncCall{Arg0: v0, sequence: sequence, slot: info.slot})
+			r0 := m.DoFunc.nextHook(info)(v0)
+			m.DoFunc.appendCall(TestClientDoFuncCall{Arg0: v0, Result0: r0, sequence: sequence, slot: info.slot})
+			return r0
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockInterfaceMethodRecordPanics(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.recordPanics = true
+	code := generateMockInterfaceMethod(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		// Do delegates to the next hook function in the queue and stores the
+		// parameter and result values of this invocation.
+		func (m *MockTestClient) Do(v0 string) bool {
+			defer m.DoFunc.recoverCall(TestClientDoFuncCall{Arg0: v0})
 			r0 := m.DoFunc.nextHook()(v0)
-			m.DoFunc.appendCall(TestClientDoFuncCall{v0, r0})
+			m.DoFunc.appendCall(TestClientDoFuncCall{Arg0: v0, Result0: r0})
 			return r0
 		}
 	`)
@@ -36,3 +61,260 @@ func TestGenerateMockInterfaceMethodVariadic(t *testing.T) {
 	`)
 	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
 }
+
+func TestGenerateMockAssertExpectationsMethod(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.expectations = true
+	code := generateMockAssertExpectationsMethod(wrappedInterface, "")
+	expected := strip(`
+		// AssertExpectations reports all unmet and over-satisfied expectations and
+		// all unexpected invocations of the methods of this mock as a single
+		// failure via the given test value. An invocation is unexpected if the
+		// method has expectations but none of them match its arguments. The return
+		// value is true if all expectations were met.
+		func (m *MockTestClient) AssertExpectations(t mocksupport.TestingT) bool {
+			var failures []string
+			failures = append(failures, m.StatusFunc.expectationFailures()...)
+			failures = append(failures, m.DoFunc.expectationFailures()...)
+			failures = append(failures, m.DofFunc.expectationFailures()...)
+			return mocksupport.ReportFailures(t, "MockTestClient expectations were not met", failures)
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockAssertAllHooksConsumedMethod(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.pendingHooks = true
+	code := generateMockAssertAllHooksConsumedMethod(wrappedInterface, "")
+	expected := strip(`
+		// AssertAllHooksConsumed reports all methods of this mock with pushed hooks
+		// or return values that were never invoked as a single failure via the
+		// given test value. The return value is true if all hook queues are empty.
+		func (m *MockTestClient) AssertAllHooksConsumed(t mocksupport.TestingT) bool {
+			var failures []string
+			if n := m.StatusFunc.PendingHooks(); n != 0 {
+				failures = append(failures, mocksupport.PendingHooksFailure("MockTestClient.Status", n))
+			}
+			if n := m.DoFunc.PendingHooks(); n != 0 {
+				failures = append(failures, mocksupport.PendingHooksFailure("MockTestClient.Do", n))
+			}
+			if n := m.DofFunc.PendingHooks(); n != 0 {
+				failures = append(failures, mocksupport.PendingHooksFailure("MockTestClient.Dof", n))
+			}
+			return mocksupport.ReportFailures(t, "MockTestClient hooks were not consumed", failures)
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockInterfaceMethodCallMetadata(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.recordCallMetadata = true
+	code := generateMockInterfaceMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Do delegates to the next hook function in the queue and stores the
+		// parameter and result values of this invocation.
+		func (m *MockTestClient) Do(v0 string) bool {
+			metadata := mocksupport.CaptureCallMetadata()
+			r0 := m.DoFunc.nextHook()(v0)
+			m.DoFunc.appendCall(TestClientDoFuncCall{Arg0: v0, Result0: r0, metadata: metadata})
+			return r0
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockResetMethod(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.resetMethods = true
+	code := generateMockResetMethod(wrappedInterface, "")
+	expected := strip(`
+		// Reset restores all methods of this mock to the state in which they were
+		// constructed. The default hooks set by the constructor are restored, and
+		// all queued hooks and recorded invocations are discarded.
+		func (m *MockTestClient) Reset() {
+			m.StatusFunc.Reset()
+			m.DoFunc.Reset()
+			m.DofFunc.Reset()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockResetMethodNameConflict(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDo, TestMethodReset)
+	wrappedInterface.resetMethods = true
+	code := generateMockResetMethod(wrappedInterface, "")
+	expected := strip(`
+		// ResetMock restores all methods of this mock to the state in which they
+		// were constructed. The default hooks set by the constructor are restored,
+		// and all queued hooks and recorded invocations are discarded.
+		func (m *MockTestClient) ResetMock() {
+			m.DoFunc.Reset()
+			m.ResetFunc.Reset()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockInterfaceMethodDeepCopyArguments(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDof)
+	wrappedInterface.deepCopyArguments = true
+	code := generateMockInterfaceMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Dof delegates to the next hook function in the queue and stores the
+		// parameter and result values of this invocation.
+		func (m *MockTestClient) Dof(v0 string, v1 ...string) bool {
+			a1 := mocksupport.DeepCopy(v1)
+			r0 := m.DofFunc.nextHook()(v0, v1...)
+			m.DofFunc.appendCall(TestClientDofFuncCall{v0, a1, r0})
+			return r0
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockInterfaceMethodParameterNames(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodGet)
+	wrappedMethod.useParameterNames(nil)
+	code := generateMockInterfaceMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Get delegates to the next hook function in the queue and stores the
+		// parameter and result values of this invocation.
+		func (m *MockTestClient) Get(key string, v1 string, v2 string, args bool) bool {
+			r0 := m.GetFunc.nextHook()(key, v1, v2, args)
+			m.GetFunc.appendCall(TestClientGetFuncCall{key, v1, v2, args, r0})
+			return r0
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockInterfaceMethodErrorResult(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	wrappedInterface.faultInjection = true
+	code := generateMockInterfaceMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Fetch delegates to the next hook function in the queue and stores the
+		// parameter and result values of this invocation.
+		func (m *MockTestClient) Fetch(v0 string) (string, error) {
+			fault := m.FetchFunc.faults.Next()
+			r0, r1 := m.FetchFunc.nextHook(fault)(v0)
+			m.FetchFunc.appendCall(TestClientFetchFuncCall{Arg0: v0, Result0: r0, Result1: r1, fault: fault})
+			return r0, r1
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockInterfaceMethodTrackConcurrency(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.trackConcurrency = true
+	code := generateMockInterfaceMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Do delegates to the next hook function in the queue and stores the
+		// parameter and result values of this invocation.
+		func (m *MockTestClient) Do(v0 string) bool {
+			m.DoFunc.beginCall()
+			defer m.DoFunc.endCall()
+			r0 := m.DoFunc.nextHook()(v0)
+			m.DoFunc.appendCall(TestClientDoFuncCall{v0, r0})
+			return r0
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockInitFuncsMethod(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.zeroValueMocks = true
+	code := generateMockInitFuncsMethod(wrappedInterface, "")
+	expected := strip(`
+		// initFuncs replaces each nil mock function object of this mock with one
+		// that returns zero values for all results. This allows a zero-value mock
+		// to be used in place of one returned by a constructor.
+		func (m *MockTestClient) initFuncs() {
+			m.mutex.Lock()
+			defer m.mutex.Unlock()
+
+			if m.StatusFunc == nil {
+				m.StatusFunc = &TestClientStatusFunc{}
+			}
+			if m.DoFunc == nil {
+				m.DoFunc = &TestClientDoFunc{}
+			}
+			if m.DofFunc == nil {
+				m.DofFunc = &TestClientDofFunc{}
+			}
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncAccessorMethod(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.zeroValueMocks = true
+	code := generateMockFuncAccessorMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// GetDoFunc returns the mock function object of the Do method. The mock
+		// function objects of a zero-value mock are created on first use, so this
+		// method allows a zero-value mock to be configured before any of its
+		// methods are invoked.
+		func (m *MockTestClient) GetDoFunc() *TestClientDoFunc {
+			m.initFuncs()
+			return m.DoFunc
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockZeroValueMethodsDisabled(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockInitFuncsMethod(wrappedInterface, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncAccessorMethod(wrappedInterface, wrappedMethod, "")))
+}
+
+func TestGenerateMockInterfaceMethodDeepCopyInterfaceArguments(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodAdd)
+	wrappedInterface.deepCopyArguments = true
+	code := generateMockInterfaceMethod(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// Add delegates to the next hook function in the queue and stores the
+		// parameter and result values of this invocation.
+		func (m *MockTestClient) Add(v0 test.Child, v1 error, v2 []string) {
+			a2 := mocksupport.DeepCopy(v2)
+			m.AddFunc.nextHook()(v0, v1, v2)
+			m.AddFunc.appendCall(TestClientAddFuncCall{v0, v1, a2})
+			return
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockResetMethodOptInFeatures(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.expectations = true
+	wrappedInterface.conditions = true
+	wrappedInterface.resetMethods = true
+	code := generateMockResetMethod(wrappedInterface, "")
+	expected := strip(`
+		// Reset restores all methods of this mock to the state in which they were
+		// constructed. The default hooks set by the constructor are restored, and
+		// all queued hooks, conditions, expectations, and recorded invocations are
+		// discarded.
+		func (m *MockTestClient) Reset() {
+			m.StatusFunc.Reset()
+			m.DoFunc.Reset()
+			m.DofFunc.Reset()
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockOptInMethodsDisabled(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockAssertExpectationsMethod(wrappedInterface, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockAssertAllHooksConsumedMethod(wrappedInterface, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockResetMethod(wrappedInterface, "")))
+}
@@ -3,6 +3,7 @@ package generation
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/dave/jennifer/jen"
 	"github.com/derision-test/go-mockgen/v2/internal/mockgen/types"
@@ -31,15 +32,100 @@ func generateMockStruct(iface *wrappedInterface, outputImportPath string) jen.Co
 		hook := compose(jen.Id(mockFuncFieldName).Op("*"), addTypes(jen.Id(mockFuncStructName), iface.TypeParams, outputImportPath, false))
 		structFields = append(structFields, addComment(hook, 2, commentText))
 	}
+	if iface.initsFuncs() {
+		structFields = append(structFields, jen.Id("mutex").Qual("sync", "Mutex"))
+	}
 
-	// <Name>Func *<Prefix><InterfaceName><Name>Func, ...
+	// <Name>Func *<Prefix><InterfaceName><Name>Func, ..., [mutex sync.Mutex]
 	return generateStruct(mockStructName, iface.TypeParams, commentText, outputImportPath, structFields)
 }
 
+func generateMockFuncsStruct(iface *wrappedInterface, constructorPrefix, outputImportPath string) jen.Code {
+	if !iface.withConstructors {
+		return jen.Null()
+	}
+
+	mockFuncsStructName := fmt.Sprintf("%sFuncs", iface.mockStructName)
+	commentText := fmt.Sprintf(
+		`%s holds a default hook for each method of %s. It is passed to New%s%sWith.`,
+		mockFuncsStructName,
+		iface.mockStructName,
+		constructorPrefix,
+		iface.mockStructName,
+	)
+
+	structFields := make([]jen.Code, 0, len(iface.wrappedMethods))
+	for _, method := range iface.wrappedMethods {
+		commentText := fmt.Sprintf(
+			`%s is the default hook of the method %s. If nil, the method returns zero values for all results.`,
+			method.Name,
+			method.Name,
+		)
+
+		structFields = append(structFields, addComment(compose(jen.Id(method.Name), method.signature), 2, commentText))
+	}
+
+	// <Name> <signature>, ...
+	return generateStruct(mockFuncsStructName, iface.TypeParams, commentText, outputImportPath, structFields)
+}
+
+func generateMockOptionType(iface *wrappedInterface, constructorPrefix, outputImportPath string) jen.Code {
+	if !iface.withConstructors {
+		return jen.Null()
+	}
+
+	mockFuncsStructName := fmt.Sprintf("%sFuncs", iface.mockStructName)
+	mockOptionTypeName := fmt.Sprintf("%sOption", iface.mockStructName)
+	commentText := fmt.Sprintf(
+		`%s modifies the %s value passed to New%s%sWith before the mock is constructed.`,
+		mockOptionTypeName,
+		mockFuncsStructName,
+		constructorPrefix,
+		iface.mockStructName,
+	)
+
+	// type Mock<Name>Option func(*Mock<Name>Funcs)
+	funcsType := compose(jen.Op("*"), addTypes(jen.Id(mockFuncsStructName), iface.TypeParams, outputImportPath, false))
+	typeDeclaration := compose(addTypes(jen.Type().Id(mockOptionTypeName), iface.TypeParams, outputImportPath, true), jen.Func().Params(funcsType))
+	return addComment(typeDeclaration, 1, commentText)
+}
+
+func generateMockCallInfoStruct(iface *wrappedInterface, outputImportPath string) jen.Code {
+	if !iface.callInfoHooks {
+		return jen.Null()
+	}
+
+	mockStructName := iface.mockStructName
+	callInfoStructName := fmt.Sprintf("%s%sCallInfo", iface.prefix, iface.titleName)
+	commentText := fmt.Sprintf(
+		`%s describes an invocation of a method on an instance of %s. It is passed to hooks registered via SetDefaultHookWithCall and PushHookWithCall.`,
+		callInfoStructName,
+		mockStructName,
+	)
+
+	mockType := compose(jen.Op("*"), addTypes(jen.Id(mockStructName), iface.TypeParams, outputImportPath, false))
+	indexField := addComment(jen.Id("Index").Int(), 2, strings.Join([]string{
+		`Index is the position of this invocation in the history of the method.`,
+		`The position is reserved when the invocation begins, and the invocation is recorded there when it returns.`,
+	}, " "))
+	mockField := addComment(compose(jen.Id("Mock"), mockType), 2, `Mock is the mock instance on which the method was invoked.`)
+	methodField := addComment(jen.Id("Method").String(), 2, `Method is the name of the invoked method.`)
+	slotField := addComment(jen.Id("slot").Int(), 2, `slot identifies the history entry reserved for this invocation, counting entries discarded by ClearHistory and Reset.`)
+
+	return generateStruct(callInfoStructName, iface.TypeParams, commentText, outputImportPath, []jen.Code{
+		indexField,  // Index int
+		mockField,   // Mock *Mock<Name>
+		methodField, // Method string
+		slotField,   // slot int
+	})
+}
+
 func generateMockFuncStruct(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
 	mockStructName := iface.mockStructName
 	mockFuncStructName := fmt.Sprintf("%s%s%sFunc", iface.prefix, iface.titleName, method.Name)
 	mockFuncCallStructName := fmt.Sprintf("%s%s%sFuncCall", iface.prefix, iface.titleName, method.Name)
+	mockFuncConditionStructName := fmt.Sprintf("%s%s%sFuncCondition", iface.prefix, iface.titleName, method.Name)
+	mockFuncExpectationStructName := fmt.Sprintf("%s%s%sFuncExpectation", iface.prefix, iface.titleName, method.Name)
 	commentText := fmt.Sprintf(
 		`%s describes the behavior when the %s method of the parent %s instance is invoked.`,
 		mockFuncStructName,
@@ -47,11 +133,80 @@ func generateMockFuncStruct(iface *wrappedInterface, method *wrappedMethod, outp
 		mockStructName,
 	)
 
-	return generateStruct(mockFuncStructName, iface.TypeParams, commentText, outputImportPath, []jen.Code{
-		compose(jen.Id("defaultHook"), method.signature),                                                                        // defaultHook <signature>
-		compose(jen.Id("hooks").Index(), method.signature),                                                                      // hooks []<signature>
+	fields := []jen.Code{
+		compose(jen.Id("defaultHook"), method.signature),   // defaultHook <signature>
+		compose(jen.Id("hooks").Index(), method.signature), // hooks []<signature>
+	}
+	if iface.callInfoHooks {
+		fields = append(fields,
+			compose(jen.Id("defaultCallHook"), callHookSignature(iface, method, outputImportPath)),   // defaultCallHook <call signature>
+			compose(jen.Id("callHooks").Index(), callHookSignature(iface, method, outputImportPath)), // callHooks []<call signature>
+		)
+	}
+	if iface.resetMethods {
+		fields = append(fields,
+			compose(jen.Id("initialHook"), method.signature), // initialHook <signature>
+			jen.Id("initialHookSaved").Bool(),                // initialHookSaved bool
+		)
+	}
+	if iface.conditions {
+		fields = append(fields, compose(jen.Id("conditions").Index().Op("*"), addTypes(jen.Id(mockFuncConditionStructName), iface.TypeParams, outputImportPath, false))) // conditions []*<prefix>FuncCondition
+	}
+	if iface.expectations {
+		fields = append(fields,
+			compose(jen.Id("expectations").Index().Op("*"), addTypes(jen.Id(mockFuncExpectationStructName), iface.TypeParams, outputImportPath, false)), // expectations []*<prefix>FuncExpectation
+			jen.Id("unexpected").Index().Index().Interface(), // unexpected [][]interface{}
+		)
+	}
+	fields = append(fields,
 		compose(jen.Id("history").Index(), addTypes(jen.Id(mockFuncCallStructName), iface.TypeParams, outputImportPath, false)), // history []<prefix>FuncCall
-		jen.Id("mutex").Qual("sync", "Mutex"),                                                                                   // mutex sync.Mutex
+	)
+	if iface.callInfoHooks {
+		fields = append(fields, jen.Id("cleared").Int()) // cleared int
+	}
+	if iface.subscriptions {
+		fields = append(fields, jen.Id("callSignal").Chan().Struct())                                                                                                                        // callSignal chan struct{}
+		fields = append(fields, jen.Id("subscriptions").Qual(supportImportPath, "Subscriptions").Types(addTypes(jen.Id(mockFuncCallStructName), iface.TypeParams, outputImportPath, false))) // subscriptions mocksupport.Subscriptions[<prefix>FuncCall]
+	}
+	if iface.faultInjection {
+		fields = append(fields, jen.Id("faults").Qual(supportImportPath, "Faults")) // faults mocksupport.Faults
+	}
+	fields = append(fields, jen.Id("mutex").Qual("sync", "Mutex")) // mutex sync.Mutex
+
+	if iface.trackConcurrency {
+		fields = append(fields,
+			jen.Id("inFlight").Int(),    // inFlight int
+			jen.Id("maxInFlight").Int(), // maxInFlight int
+		)
+	}
+	for i := range method.Results {
+		if name, ok := iface.resultMockName(method, i); ok && name != iface.mockStructName {
+			// result<n>Mock *<ResultMockStruct>
+			fields = append(fields, jen.Id(fmt.Sprintf("result%dMock", i)).Op("*").Id(name))
+		}
+	}
+	return generateStruct(mockFuncStructName, iface.TypeParams, commentText, outputImportPath, fields)
+}
+
+func generateMockFuncConditionStruct(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.conditions {
+		return jen.Null()
+	}
+
+	mockStructName := iface.mockStructName
+	mockFuncStructName := fmt.Sprintf("%s%s%sFunc", iface.prefix, iface.titleName, method.Name)
+	mockFuncConditionStructName := fmt.Sprintf("%s%s%sFuncCondition", iface.prefix, iface.titleName, method.Name)
+	commentText := fmt.Sprintf(
+		`%s describes the behavior when the %s method of the parent %s instance is invoked with arguments accepted by a matcher function.`,
+		mockFuncConditionStructName,
+		method.Name,
+		mockStructName,
+	)
+
+	return generateStruct(mockFuncConditionStructName, iface.TypeParams, commentText, outputImportPath, []jen.Code{
+		compose(jen.Id("f").Op("*"), addTypes(jen.Id(mockFuncStructName), iface.TypeParams, outputImportPath, false)), // f *<prefix>Func
+		compose(jen.Id("match"), generateMatcherType(method)),                                                         // match func(T<n>, ...) bool
+		compose(jen.Id("hook"), method.signature),                                                                     // hook <signature>
 	})
 }
 
@@ -65,20 +220,118 @@ func generateMockFuncCallStruct(iface *wrappedInterface, method *wrappedMethod,
 		mockStructName,
 	)
 
-	makeFields := func(prefix string, params []jen.Code, makeComment commentFactory) []jen.Code {
+	makeFields := func(names []string, params []jen.Code, makeComment commentFactory) []jen.Code {
 		fields := make([]jen.Code, 0, len(params))
 		for i, param := range params {
-			name := prefix + strconv.Itoa(i)
-			field := jen.Id(name).Add(param)
-			fields = append(fields, addComment(field, 2, makeComment(method, name, i)))
+			field := jen.Id(names[i]).Add(param)
+			fields = append(fields, addComment(field, 2, makeComment(method, names[i], i)))
 		}
 
 		return fields
 	}
 
-	argFields := makeFields("Arg", method.dotlessParamTypes, argFieldComment)    // Arg<n> <ParamType #n>, ...
-	resultFields := makeFields("Result", method.resultTypes, resultFieldComment) // Result<n> <ResultType #n>, ...
-	return generateStruct(mockFuncCallStructName, iface.TypeParams, commentText, outputImportPath, append(argFields, resultFields...))
+	resultNames := make([]string, 0, len(method.resultTypes))
+	for i := range method.resultTypes {
+		resultNames = append(resultNames, "Result"+strconv.Itoa(i))
+	}
+
+	argFields := makeFields(method.argFieldNames, method.dotlessParamTypes, argFieldComment) // Arg<n> <ParamType #n>, ...
+	resultFields := makeFields(resultNames, method.resultTypes, resultFieldComment)          // Result<n> <ResultType #n>, ...
+
+	fields := append(argFields, resultFields...)
+	if iface.callSequence {
+		sequenceField := addComment(jen.Id("sequence").Uint64(), 2, `sequence is the process-wide position of this invocation among the invocations of all mock functions, taken when the invocation begins.`)
+		fields = append(fields, sequenceField) // sequence uint64
+	}
+	if iface.recordPanics {
+		panicValueField := addComment(jen.Id("panicValue").Interface(), 2, `panicValue is the value with which the hook handling this invocation panicked, if any.`)
+		fields = append(fields, panicValueField) // panicValue interface{}
+	}
+	if iface.callInfoHooks {
+		slotField := addComment(jen.Id("slot").Int(), 2, `slot identifies the history entry that this invocation fills when it returns.`)
+		fields = append(fields, slotField) // slot int
+	}
+
+	if iface.faultInjection {
+		faultField := addComment(jen.Id("fault").Qual(supportImportPath, "Fault"), 2, `fault describes the latency and error injected into this invocation, if any.`)
+		fields = append(fields, faultField) // fault mocksupport.Fault
+	}
+	if iface.recordCallMetadata {
+		metadataField := addComment(jen.Id("metadata").Qual(supportImportPath, "CallMetadata"), 2, `metadata is the time, goroutine, and caller location of this invocation.`)
+		fields = append(fields, metadataField) // metadata mocksupport.CallMetadata
+	}
+	return generateStruct(mockFuncCallStructName, iface.TypeParams, commentText, outputImportPath, fields)
+}
+
+func generateMockFuncResultsStruct(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.returnSequences && !iface.blockingHooks {
+		return jen.Null()
+	}
+
+	mockStructName := iface.mockStructName
+	mockFuncResultsStructName := fmt.Sprintf("%s%s%sResults", iface.prefix, iface.titleName, method.Name)
+	commentText := fmt.Sprintf(
+		`%s is a set of values to be returned from an invocation of method %s on an instance of %s.`,
+		mockFuncResultsStructName,
+		method.Name,
+		mockStructName,
+	)
+
+	fields := make([]jen.Code, 0, len(method.resultTypes))
+	for i, typ := range method.resultTypes {
+		name := "Result" + strconv.Itoa(i)
+		field := jen.Id(name).Add(typ)
+		fields = append(fields, addComment(field, 2, returnedResultFieldComment(method, name, i))) // Result<n> <ResultType #n>
+	}
+
+	return generateStruct(mockFuncResultsStructName, iface.TypeParams, commentText, outputImportPath, fields)
+}
+
+func generateMockFuncGateStruct(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.blockingHooks {
+		return jen.Null()
+	}
+
+	mockStructName := iface.mockStructName
+	mockFuncGateStructName := fmt.Sprintf("%s%s%sFuncGate", iface.prefix, iface.titleName, method.Name)
+	mockFuncResultsStructName := fmt.Sprintf("%s%s%sResults", iface.prefix, iface.titleName, method.Name)
+	commentText := fmt.Sprintf(
+		`%s blocks an invocation of method %s on an instance of %s until it is released by the test.`,
+		mockFuncGateStructName,
+		method.Name,
+		mockStructName,
+	)
+
+	return generateStruct(mockFuncGateStructName, iface.TypeParams, commentText, outputImportPath, []jen.Code{
+		jen.Id("entered").Chan().Struct(), // entered chan struct{}
+		compose(jen.Id("results").Chan(), addTypes(jen.Id(mockFuncResultsStructName), iface.TypeParams, outputImportPath, false)), // results chan <prefix>Results
+		jen.Id("released").Qual("sync/atomic", "Bool"), // released atomic.Bool
+	})
+}
+
+func generateMockFuncExpectationStruct(iface *wrappedInterface, method *wrappedMethod, outputImportPath string) jen.Code {
+	if !iface.expectations {
+		return jen.Null()
+	}
+
+	mockStructName := iface.mockStructName
+	mockFuncStructName := fmt.Sprintf("%s%s%sFunc", iface.prefix, iface.titleName, method.Name)
+	mockFuncExpectationStructName := fmt.Sprintf("%s%s%sFuncExpectation", iface.prefix, iface.titleName, method.Name)
+	commentText := fmt.Sprintf(
+		`%s describes an expected number of invocations of the %s method of the parent %s instance with arguments accepted by a matcher function.`,
+		mockFuncExpectationStructName,
+		method.Name,
+		mockStructName,
+	)
+
+	return generateStruct(mockFuncExpectationStructName, iface.TypeParams, commentText, outputImportPath, []jen.Code{
+		compose(jen.Id("f").Op("*"), addTypes(jen.Id(mockFuncStructName), iface.TypeParams, outputImportPath, false)), // f *<prefix>Func
+		jen.Id("args").Index().Interface(),                    // args []interface{}
+		compose(jen.Id("match"), generateMatcherType(method)), // match func(T<n>, ...) bool
+		jen.Id("times").Int(),                                 // times int
+		jen.Id("calls").Int(),                                 // calls int
+		compose(jen.Id("hook"), method.signature),             // hook <signature>
+	})
 }
 
 func generateStruct(name string, typeParams []types.TypeParam, commentText, outputImportPath string, structFields []jen.Code) jen.Code {
@@ -91,6 +344,7 @@ type commentFactory func(method *wrappedMethod, name string, i int) string
 var (
 	_ commentFactory = argFieldComment
 	_ commentFactory = resultFieldComment
+	_ commentFactory = returnedResultFieldComment
 )
 
 func argFieldComment(method *wrappedMethod, name string, i int) string {
@@ -115,3 +369,11 @@ func resultFieldComment(method *wrappedMethod, name string, i int) string {
 		humanize.Ordinal(i+1),
 	)
 }
+
+func returnedResultFieldComment(method *wrappedMethod, name string, i int) string {
+	return fmt.Sprintf(
+		`%s is the value of the %s result to return from the method invocation.`,
+		name,
+		humanize.Ordinal(i+1),
+	)
+}
@@ -8,7 +8,9 @@ import (
 )
 
 func TestGenerateMockStruct(t *testing.T) {
-	code := generateMockStruct(makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof), "")
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.zeroValueMocks = true
+	code := generateMockStruct(wrappedInterface, "")
 	expected := strip(`
 		// MockTestClient is a mock implementation of the Client interface (from the
 		// package github.com/derision-test/go-mockgen/v2/test) used for unit
@@ -23,6 +25,7 @@ func TestGenerateMockStruct(t *testing.T) {
 			// DofFunc is an instance of a mock function object controlling the
 			// behavior of the method Dof.
 			DofFunc *TestClientDofFunc
+			mutex   sync.Mutex
 		}
 	`)
 	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
@@ -30,15 +33,19 @@ func TestGenerateMockStruct(t *testing.T) {
 
 func TestGenerateFuncStruct(t *testing.T) {
 	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.callInfoHooks = true
 	code := generateMockFuncStruct(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
 	expected := strip(`
 		// TestClientDoFunc describes the behavior when the Do method of the parent
 		// MockTestClient instance is invoked.
 		type TestClientDoFunc struct {
-			defaultHook func(string) bool
-			hooks       []func(string) bool
-			history     []TestClientDoFuncCall
-			mutex       sync.Mutex
+			defaultHook     func(string) bool
+			hooks           []func(string) bool
+			defaultCallHook func(TestClientCallInfo, string) bool
+			callHooks       []func(TestClientCallInfo, string) bool
+			history         []TestClientDoFuncCall
+			cleared         int
+			mutex           sync.Mutex
 		}
 	`)
 	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
@@ -62,6 +69,29 @@ func TestGenerateFuncStructVariadic(t *testing.T) {
 
 func TestGenerateMockFuncCallStruct(t *testing.T) {
 	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.callSequence = true
+	code := generateMockFuncCallStruct(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		// TestClientDoFuncCall is an object that describes an invocation of method
+		// Do on an instance of MockTestClient.
+		type TestClientDoFuncCall struct {
+			// Arg0 is the value of the 1st argument passed to this method
+			// invocation.
+			Arg0 string
+			// Result0 is the value of the 1st result returned from this method
+			// invocation.
+			Result0 bool
+			// sequence is the process-wide position of this invocation among the
+			// invocations of all mock functions, taken when the invocation begins.
+			sequence uint64
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncCallStructRecordPanics(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.recordPanics = true
 	code := generateMockFuncCallStruct(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
 	expected := strip(`
 		// TestClientDoFuncCall is an object that describes an invocation of method
@@ -73,6 +103,9 @@ func TestGenerateMockFuncCallStruct(t *testing.T) {
 			// Result0 is the value of the 1st result returned from this method
 			// invocation.
 			Result0 bool
+			// panicValue is the value with which the hook handling this invocation
+			// panicked, if any.
+			panicValue interface{}
 		}
 	`)
 	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
@@ -99,3 +132,310 @@ func TestGenerateMockFuncCallStructVariadic(t *testing.T) {
 
 	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
 }
+
+func TestGenerateMockFuncConditionStruct(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.conditions = true
+	code := generateMockFuncConditionStruct(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		// TestClientDoFuncCondition describes the behavior when the Do method of
+		// the parent MockTestClient instance is invoked with arguments accepted by
+		// a matcher function.
+		type TestClientDoFuncCondition struct {
+			f     *TestClientDoFunc
+			match func(string) bool
+			hook  func(string) bool
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncExpectationStruct(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.expectations = true
+	code := generateMockFuncExpectationStruct(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// TestClientDoFuncExpectation describes an expected number of invocations
+		// of the Do method of the parent MockTestClient instance with arguments
+		// accepted by a matcher function.
+		type TestClientDoFuncExpectation struct {
+			f     *TestClientDoFunc
+			args  []interface{}
+			match func(string) bool
+			times int
+			calls int
+			hook  func(string) bool
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncCallStructCallMetadata(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.recordCallMetadata = true
+	code := generateMockFuncCallStruct(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// TestClientDoFuncCall is an object that describes an invocation of method
+		// Do on an instance of MockTestClient.
+		type TestClientDoFuncCall struct {
+			// Arg0 is the value of the 1st argument passed to this method
+			// invocation.
+			Arg0 string
+			// Result0 is the value of the 1st result returned from this method
+			// invocation.
+			Result0 bool
+			// metadata is the time, goroutine, and caller location of this
+			// invocation.
+			metadata mocksupport.CallMetadata
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncResultsStruct(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	wrappedInterface.blockingHooks = true
+	code := generateMockFuncResultsStruct(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// TestClientFetchResults is a set of values to be returned from an
+		// invocation of method Fetch on an instance of MockTestClient.
+		type TestClientFetchResults struct {
+			// Result0 is the value of the 1st result to return from the method
+			// invocation.
+			Result0 string
+			// Result1 is the value of the 2nd result to return from the method
+			// invocation.
+			Result1 error
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockCallInfoStruct(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDo)
+	wrappedInterface.callInfoHooks = true
+	code := generateMockCallInfoStruct(wrappedInterface, "")
+	expected := strip(`
+		// TestClientCallInfo describes an invocation of a method on an instance of
+		// MockTestClient. It is passed to hooks registered via
+		// SetDefaultHookWithCall and PushHookWithCall.
+		type TestClientCallInfo struct {
+			// Index is the position of this invocation in the history of the
+			// method. The position is reserved when the invocation begins, and the
+			// invocation is recorded there when it returns.
+			Index int
+			// Mock is the mock instance on which the method was invoked.
+			Mock *MockTestClient
+			// Method is the name of the invoked method.
+			Method string
+			// slot identifies the history entry reserved for this invocation,
+			// counting entries discarded by ClearHistory and Reset.
+			slot int
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncCallStructParameterNames(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodGet)
+	wrappedMethod.useParameterNames(nil)
+	code := generateMockFuncCallStruct(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// TestClientGetFuncCall is an object that describes an invocation of method
+		// Get on an instance of MockTestClient.
+		type TestClientGetFuncCall struct {
+			// Key is the value of the 1st argument passed to this method
+			// invocation.
+			Key string
+			// Arg1 is the value of the 2nd argument passed to this method
+			// invocation.
+			Arg1 string
+			// M is the value of the 3rd argument passed to this method invocation.
+			M string
+			// Arg3 is the value of the 4th argument passed to this method
+			// invocation.
+			Arg3 bool
+			// Result0 is the value of the 1st result returned from this method
+			// invocation.
+			Result0 bool
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateFuncStructParameterNames(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodGet)
+	wrappedMethod.useParameterNames(nil)
+	code := generateMockFuncStruct(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// TestClientGetFunc describes the behavior when the Get method of the
+		// parent MockTestClient instance is invoked.
+		type TestClientGetFunc struct {
+			defaultHook func(key string, v1 string, v2 string, args bool) bool
+			hooks       []func(key string, v1 string, v2 string, args bool) bool
+			history     []TestClientGetFuncCall
+			mutex       sync.Mutex
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncGateStruct(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.blockingHooks = true
+	code := generateMockFuncGateStruct(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// TestClientDoFuncGate blocks an invocation of method Do on an instance of
+		// MockTestClient until it is released by the test.
+		type TestClientDoFuncGate struct {
+			entered  chan struct{}
+			results  chan TestClientDoResults
+			released atomic.Bool
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateFuncStructTrackConcurrency(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.trackConcurrency = true
+	code := generateMockFuncStruct(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// TestClientDoFunc describes the behavior when the Do method of the parent
+		// MockTestClient instance is invoked.
+		type TestClientDoFunc struct {
+			defaultHook func(string) bool
+			hooks       []func(string) bool
+			history     []TestClientDoFuncCall
+			mutex       sync.Mutex
+			inFlight    int
+			maxInFlight int
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockFuncsStruct(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.withConstructors = true
+	code := generateMockFuncsStruct(wrappedInterface, "", "")
+	expected := strip(`
+		// MockTestClientFuncs holds a default hook for each method of
+		// MockTestClient. It is passed to NewMockTestClientWith.
+		type MockTestClientFuncs struct {
+			// Status is the default hook of the method Status. If nil, the method
+			// returns zero values for all results.
+			Status func() (string, bool)
+			// Do is the default hook of the method Do. If nil, the method returns
+			// zero values for all results.
+			Do func(string) bool
+			// Dof is the default hook of the method Dof. If nil, the method returns
+			// zero values for all results.
+			Dof func(string, ...string) bool
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockOptionType(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	wrappedInterface.withConstructors = true
+	code := generateMockOptionType(wrappedInterface, "", "")
+	expected := strip(`
+		// MockTestClientOption modifies the MockTestClientFuncs value passed to
+		// NewMockTestClientWith before the mock is constructed.
+		type MockTestClientOption func(*MockTestClientFuncs)
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateFuncStructRecursiveMocks(t *testing.T) {
+	wrappedInterface := makeRecursiveInterface(TestMethodChild, TestMethodWith)
+	code := generateMockFuncStruct(wrappedInterface, wrappedInterface.wrappedMethods[0], "")
+	expected := strip(`
+		// TestClientChildFunc describes the behavior when the Child method of the
+		// parent MockTestClient instance is invoked.
+		type TestClientChildFunc struct {
+			defaultHook func(string) (test.Child, error)
+			hooks       []func(string) (test.Child, error)
+			history     []TestClientChildFuncCall
+			mutex       sync.Mutex
+			result0Mock *MockTestChild
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateFuncStructOptInFeatures(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.expectations = true
+	wrappedInterface.subscriptions = true
+	wrappedInterface.faultInjection = true
+	wrappedInterface.conditions = true
+	wrappedInterface.callInfoHooks = true
+	code := generateMockFuncStruct(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// TestClientDoFunc describes the behavior when the Do method of the parent
+		// MockTestClient instance is invoked.
+		type TestClientDoFunc struct {
+			defaultHook     func(string) bool
+			hooks           []func(string) bool
+			defaultCallHook func(TestClientCallInfo, string) bool
+			callHooks       []func(TestClientCallInfo, string) bool
+			conditions      []*TestClientDoFuncCondition
+			expectations    []*TestClientDoFuncExpectation
+			unexpected      [][]interface{}
+			history         []TestClientDoFuncCall
+			cleared         int
+			callSignal      chan struct{}
+			subscriptions   mocksupport.Subscriptions[TestClientDoFuncCall]
+			faults          mocksupport.Faults
+			mutex           sync.Mutex
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
+
+func TestGenerateMockCallInfoStructDisabled(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodDo)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockCallInfoStruct(wrappedInterface, "")))
+}
+
+func TestGenerateMockFuncGateStructDisabled(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodFetch)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncResultsStruct(wrappedInterface, wrappedMethod, "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncGateStruct(wrappedInterface, wrappedMethod, "")))
+}
+
+func TestGenerateMockFuncsStructDisabled(t *testing.T) {
+	wrappedInterface := makeInterface(TestMethodStatus, TestMethodDo, TestMethodDof)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncsStruct(wrappedInterface, "", "")))
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockOptionType(wrappedInterface, "", "")))
+}
+
+func TestGenerateMockFuncExpectationStructDisabled(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	assert.Equal(t, "", fmt.Sprintf("%#v", generateMockFuncExpectationStruct(wrappedInterface, wrappedMethod, "")))
+}
+
+func TestGenerateMockFuncCallStructFaultInjection(t *testing.T) {
+	wrappedInterface, wrappedMethod := makeMethod(TestMethodDo)
+	wrappedInterface.faultInjection = true
+	code := generateMockFuncCallStruct(wrappedInterface, wrappedMethod, "")
+	expected := strip(`
+		// TestClientDoFuncCall is an object that describes an invocation of method
+		// Do on an instance of MockTestClient.
+		type TestClientDoFuncCall struct {
+			// Arg0 is the value of the 1st argument passed to this method
+			// invocation.
+			Arg0 string
+			// Result0 is the value of the 1st result returned from this method
+			// invocation.
+			Result0 bool
+			// fault describes the latency and error injected into this invocation,
+			// if any.
+			fault mocksupport.Fault
+		}
+	`)
+	assert.Equal(t, expected, fmt.Sprintf("%#v", code))
+}
@@ -2,9 +2,11 @@ package generation
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/dave/jennifer/jen"
+	"github.com/derision-test/go-mockgen/v2/internal/mockgen/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -17,6 +19,10 @@ func TestGenerateInterface(t *testing.T) {
 		"type TestClientDofFunc struct",
 		"type TestClientDofFuncCall struct",
 		"func NewMockTestClient() *MockTestClient",
+		"func NewMockTestClientT(t testing.TB) *MockTestClient",
+		"func (m *MockTestClient) AssertExpectations(t mocksupport.TestingT) bool",
+		"func (m *MockTestClient) AssertAllHooksConsumed(t mocksupport.TestingT) bool",
+		"func (m *MockTestClient) Reset()",
 		// Overrides
 		"func (m *MockTestClient) Do(v0 string) bool",
 		"func (m *MockTestClient) Dof(v0 string, v1 ...string) bool",
@@ -25,10 +31,31 @@ func TestGenerateInterface(t *testing.T) {
 		"func (f *TestClientDoFunc) PushHook(hook func(string) bool)",
 		"func (f *TestClientDoFunc) SetDefaultReturn(r0 bool)",
 		"func (f *TestClientDoFunc) PushReturn(r0 bool)",
+		"func (f *TestClientDoFunc) SetDefaultHookWithCall(hook func(TestClientCallInfo, string) bool)",
+		"func (f *TestClientDoFunc) PushHookWithCall(hook func(TestClientCallInfo, string) bool)",
+		"func (f *TestClientDoFunc) When(v0 string) *TestClientDoFuncCondition",
+		"func (f *TestClientDoFunc) WhenFunc(match func(string) bool) *TestClientDoFuncCondition",
+		"func (f *TestClientDoFunc) Expect(v0 string) *TestClientDoFuncExpectation",
+		"func (f *TestClientDoFunc) ExpectFunc(match func(string) bool) *TestClientDoFuncExpectation",
 		"func (f *TestClientDoFunc) History() []TestClientDoFuncCall",
+		"func (f *TestClientDoFunc) WaitForCalls(ctx context.Context, n int) error",
+		"func (f *TestClientDoFunc) Calls(ctx context.Context) <-chan TestClientDoFuncCall",
+		"func (f *TestClientDoFunc) PendingHooks() int",
+		"func (f *TestClientDoFunc) ClearHistory()",
+		"func (f *TestClientDoFunc) ClearHooks()",
+		"func (f *TestClientDoFunc) Reset()",
 		// DoFuncCall methods
 		"func (c TestClientDoFuncCall) Args() []interface{}",
 		"func (c TestClientDoFuncCall) Results() []interface{}",
+		"func (c TestClientDoFuncCall) Sequence() uint64",
+		"func (c TestClientDoFuncCall) PanicValue() interface{}",
+		// DoFuncCondition methods
+		"func (c *TestClientDoFuncCondition) Hook(hook func(string) bool)",
+		"func (c *TestClientDoFuncCondition) Return(r0 bool)",
+		// DoFuncExpectation methods
+		"func (e *TestClientDoFuncExpectation) Times(n int) *TestClientDoFuncExpectation",
+		"func (e *TestClientDoFuncExpectation) Hook(hook func(string) bool)",
+		"func (e *TestClientDoFuncExpectation) Return(r0 bool)",
 		// DofFunc Methods
 		"func (f *TestClientDofFunc) SetDefaultHook(hook func(string, ...string) bool)",
 		"func (f *TestClientDofFunc) PushHook(hook func(string, ...string) bool)",
@@ -42,7 +69,7 @@ func TestGenerateInterface(t *testing.T) {
 
 	file := jen.NewFile("test")
 
-	generateInterface(file, makeBareInterface(TestMethodDo, TestMethodDof), TestPrefix, "", "")
+	assert.NoError(t, generateInterface(file, makeBareInterface(TestMethodDo, TestMethodDof), ContentOptions{Prefix: TestPrefix, TestConstructors: true, PendingHooks: true, CallSequence: true, ResetMethods: true, CallInfoHooks: true, RecordPanics: true, Conditions: true, Expectations: true, Subscriptions: true}))
 	rendered := fmt.Sprintf("%#v\n", file)
 
 	for _, decl := range expectedDecls {
@@ -50,6 +77,138 @@ func TestGenerateInterface(t *testing.T) {
 	}
 }
 
+func TestGenerateInterfaceOptInFeaturesDisabled(t *testing.T) {
+	unexpectedDecls := []string{
+		"type TestClientDoFuncCondition struct",
+		"func (f *TestClientDoFunc) When(v0 string) *TestClientDoFuncCondition",
+		"func (f *TestClientDoFunc) WhenFunc(match func(string) bool) *TestClientDoFuncCondition",
+		"type TestClientDoFuncExpectation struct",
+		"func (m *MockTestClient) AssertExpectations(t mocksupport.TestingT) bool",
+		"func (f *TestClientDoFunc) Expect(v0 string) *TestClientDoFuncExpectation",
+		"func (f *TestClientDoFunc) ExpectFunc(match func(string) bool) *TestClientDoFuncExpectation",
+		"func (f *TestClientDoFunc) WaitForCalls(ctx context.Context, n int) error",
+		"func (f *TestClientDoFunc) Calls(ctx context.Context) <-chan TestClientDoFuncCall",
+		"func (f *TestClientDoFunc) InjectLatency(d time.Duration)",
+		"func (f *TestClientDoFunc) SetFaultSeed(seed int64)",
+		"func (c TestClientDoFuncCall) Fault() mocksupport.Fault",
+		"func NewMockTestClientT(",
+		"func NewStrictMockTestClientT(",
+		"func NewMockTestClientFromT(",
+		"func (m *MockTestClient) AssertAllHooksConsumed(t mocksupport.TestingT) bool",
+		"func (f *TestClientDoFunc) PendingHooks() int",
+		"func (c TestClientDoFuncCall) Sequence() uint64",
+		"func (m *MockTestClient) Reset()",
+		"func (f *TestClientDoFunc) ClearHistory()",
+		"func (f *TestClientDoFunc) ClearHooks()",
+		"func (f *TestClientDoFunc) Reset()",
+		"type TestClientCallInfo struct",
+		"func (f *TestClientDoFunc) SetDefaultHookWithCall(",
+		"func (f *TestClientDoFunc) PushHookWithCall(",
+		"func NewRecordingMockTestClient(",
+		"func NewMockTestClientFromRecording(",
+		"func (f *TestClientDoFunc) recoverCall(",
+		"func (c TestClientDoFuncCall) PanicValue() interface{}",
+		"func NewRandomMockTestClient(",
+		"func (f *TestClientDoFunc) PushBlockingHook() *TestClientDoFuncGate",
+		"type TestClientDoFuncGate struct",
+		"type TestClientDoResults struct",
+		"func (m *MockTestClient) initFuncs()",
+		"func (m *MockTestClient) GetDoFunc() *TestClientDoFunc",
+		"type MockTestClientFuncs struct",
+		"type MockTestClientOption func(*MockTestClientFuncs)",
+		"func NewMockTestClientWith(",
+		"func WithTestClientDo(",
+		"mutex sync.Mutex\n}\n\n// TestClientDoFunc",
+	}
+
+	file := jen.NewFile("test")
+
+	assert.NoError(t, generateInterface(file, makeBareInterface(TestMethodDo, TestMethodDof), ContentOptions{Prefix: TestPrefix}))
+	rendered := fmt.Sprintf("%#v\n", file)
+
+	for _, decl := range unexpectedDecls {
+		assert.NotContains(t, rendered, decl)
+	}
+
+	// Mocks without opt-in features import only sync and the packages of the mocked interface
+	assert.Contains(t, rendered, `"sync"`)
+	for _, importPath := range []string{"testutil/mocksupport", `"testing"`, `"sync/atomic"`, `"context"`, `"time"`} {
+		assert.NotContains(t, rendered, importPath)
+	}
+}
+
+func TestGenerateInterfaceResetMethodConflict(t *testing.T) {
+	file := jen.NewFile("test")
+
+	assert.NoError(t, generateInterface(file, makeBareInterface(TestMethodDo, TestMethodReset), ContentOptions{Prefix: TestPrefix, ResetMethods: true}))
+	rendered := fmt.Sprintf("%#v\n", file)
+
+	// The interface method is mocked, and the mock is reset by ResetMock
+	assert.Equal(t, 1, strings.Count(rendered, "func (m *MockTestClient) Reset()"))
+	assert.Contains(t, rendered, "func (m *MockTestClient) ResetMock()")
+	assert.Contains(t, rendered, "func (f *TestClientResetFunc) Reset()")
+}
+
+func TestGenerateInterfaceReservedNameConflict(t *testing.T) {
+	testCases := []struct {
+		name    string
+		method  string
+		opts    ContentOptions
+		message string
+	}{
+		{
+			name:    "assert expectations",
+			method:  "AssertExpectations",
+			opts:    ContentOptions{Expectations: true},
+			message: "method AssertExpectations of interface Client conflicts with the AssertExpectations declared on MockTestClient by the expectations flag",
+		},
+		{
+			name:    "assert all hooks consumed",
+			method:  "AssertAllHooksConsumed",
+			opts:    ContentOptions{PendingHooks: true},
+			message: "method AssertAllHooksConsumed of interface Client conflicts with the AssertAllHooksConsumed declared on MockTestClient by the pending-hooks flag",
+		},
+		{
+			name:    "init funcs",
+			method:  "initFuncs",
+			opts:    ContentOptions{ZeroValueMocks: true},
+			message: "method initFuncs of interface Client conflicts with the initFuncs declared on MockTestClient by the zero-value-mocks flag",
+		},
+		{
+			name:    "mutex",
+			method:  "mutex",
+			opts:    ContentOptions{ZeroValueMocks: true},
+			message: "method mutex of interface Client conflicts with the mutex declared on MockTestClient by the zero-value-mocks flag",
+		},
+		{
+			name:    "accessor",
+			method:  "GetDoFunc",
+			opts:    ContentOptions{ZeroValueMocks: true},
+			message: "method GetDoFunc of interface Client conflicts with the GetDoFunc declared on MockTestClient by the zero-value-mocks flag",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			opts := testCase.opts
+			opts.Prefix = TestPrefix
+			iface := makeBareInterface(TestMethodDo, &types.Method{Name: testCase.method})
+
+			err := generateInterface(jen.NewFile("test"), iface, opts)
+			assert.EqualError(t, err, testCase.message)
+
+			// The same interface is mocked without the flag declaring the conflicting name
+			assert.NoError(t, generateInterface(jen.NewFile("test"), iface, ContentOptions{Prefix: TestPrefix}))
+		})
+	}
+}
+
+func TestGenerateInterfaceResetMockConflict(t *testing.T) {
+	iface := makeBareInterface(TestMethodDo, TestMethodReset, &types.Method{Name: "ResetMock"})
+	err := generateInterface(jen.NewFile("test"), iface, ContentOptions{Prefix: TestPrefix, ResetMethods: true})
+	assert.EqualError(t, err, "method ResetMock of interface Client conflicts with the ResetMock declared on MockTestClient by the reset-methods flag")
+}
+
 func TestGenerateContent(t *testing.T) {
 	t.Run("with generated by header only", func(t *testing.T) {
 		pkg := "testpkg"
@@ -18,6 +18,7 @@ var (
 	boolType        = getType(gotypes.Bool)
 	stringType      = getType(gotypes.String)
 	stringSliceType = gotypes.NewSlice(getType(gotypes.String))
+	errorType       = gotypes.Universe.Lookup("error").Type()
 
 	TestMethodStatus = &types.Method{
 		Name:    "Status",
@@ -31,14 +32,68 @@ var (
 		Results: []gotypes.Type{boolType},
 	}
 
+	TestMethodFetch = &types.Method{
+		Name:    "Fetch",
+		Params:  []gotypes.Type{stringType},
+		Results: []gotypes.Type{stringType, errorType},
+	}
+
+	TestMethodClose = &types.Method{
+		Name:    "Close",
+		Params:  []gotypes.Type{},
+		Results: []gotypes.Type{errorType},
+	}
+
+	TestMethodGet = &types.Method{
+		Name:       "Get",
+		Params:     []gotypes.Type{stringType, stringType, stringType, boolType},
+		ParamNames: []string{"key", "_", "m", "args"},
+		Results:    []gotypes.Type{boolType},
+	}
+
 	TestMethodDof = &types.Method{
 		Name:     "Dof",
 		Params:   []gotypes.Type{stringType, stringSliceType},
 		Results:  []gotypes.Type{boolType},
 		Variadic: true,
 	}
+
+	testPackage     = gotypes.NewPackage(TestImportPath, "test")
+	childType       = newInterfaceType(testPackage, "Child")
+	clientType      = newInterfaceType(testPackage, TestTitleName)
+	TestMethodChild = &types.Method{
+		Name:    "Child",
+		Params:  []gotypes.Type{stringType},
+		Results: []gotypes.Type{childType, errorType},
+	}
+
+	TestMethodWith = &types.Method{
+		Name:    "With",
+		Params:  []gotypes.Type{stringType},
+		Results: []gotypes.Type{clientType},
+	}
+
+	TestMethodAdd = &types.Method{
+		Name:   "Add",
+		Params: []gotypes.Type{childType, errorType, stringSliceType},
+	}
+
+	TestMethodReset = &types.Method{
+		Name: "Reset",
+	}
+
+	timeType       = gotypes.NewNamed(gotypes.NewTypeName(0, gotypes.NewPackage("time", "time"), "Time", nil), gotypes.NewStruct(nil, nil), nil)
+	TestMethodList = &types.Method{
+		Name:    "List",
+		Params:  []gotypes.Type{stringType},
+		Results: []gotypes.Type{stringSliceType, gotypes.NewMap(stringType, boolType), timeType, errorType},
+	}
 )
 
+func newInterfaceType(pkg *gotypes.Package, name string) gotypes.Type {
+	return gotypes.NewNamed(gotypes.NewTypeName(0, pkg, name, nil), gotypes.NewInterfaceType(nil, nil).Complete(), nil)
+}
+
 func getType(kind gotypes.BasicKind) gotypes.Type {
 	return gotypes.Typ[kind].Underlying()
 }
@@ -55,6 +110,16 @@ func makeInterface(methods ...*types.Method) *wrappedInterface {
 	return wrapInterface(makeBareInterface(methods...), TestPrefix, TestTitleName, TestMockStructName, "")
 }
 
+func makeRecursiveInterface(methods ...*types.Method) *wrappedInterface {
+	wrapped := makeInterface(methods...)
+	wrapped.generatedMocks = map[string]string{
+		TestImportPath + ".Child":            "MockTestChild",
+		TestImportPath + "." + TestTitleName: TestMockStructName,
+	}
+
+	return wrapped
+}
+
 func makeMethod(methods ...*types.Method) (*wrappedInterface, *wrappedMethod) {
 	wrapped := makeInterface(methods...)
 	return wrapped, wrapped.wrappedMethods[0]
@@ -1,6 +1,8 @@
 package generation
 
 import (
+	gotypes "go/types"
+
 	"github.com/dave/jennifer/jen"
 	"github.com/derision-test/go-mockgen/v2/internal/mockgen/types"
 )
@@ -43,3 +45,20 @@ func addTypes(code *jen.Statement, typeParams []types.TypeParam, outputImportPat
 
 	return compose(code, jen.Types(types...))
 }
+
+// isDeepCopied returns true if arguments of the given type are deep copied when the
+// deep-copy-arguments flag is set. Values of basic types cannot share memory with other
+// values, so copying them deeply is equivalent to assignment. Values of interface types
+// are recorded as passed, as the argument does not own the value behind the interface.
+func isDeepCopied(typ gotypes.Type) bool {
+	if _, ok := typ.(*gotypes.TypeParam); ok {
+		return true
+	}
+
+	switch typ.Underlying().(type) {
+	case *gotypes.Basic, *gotypes.Interface:
+		return false
+	}
+
+	return true
+}
@@ -1,6 +1,11 @@
 package generation
 
-import "github.com/derision-test/go-mockgen/v2/internal/mockgen/types"
+import (
+	"fmt"
+	gotypes "go/types"
+
+	"github.com/derision-test/go-mockgen/v2/internal/mockgen/types"
+)
 
 type wrappedInterface struct {
 	*types.Interface
@@ -8,6 +13,107 @@ type wrappedInterface struct {
 	titleName      string
 	mockStructName string
 	wrappedMethods []*wrappedMethod
+
+	// recordCallMetadata indicates that call structs should capture the time,
+	// goroutine, and caller location of each invocation.
+	recordCallMetadata bool
+
+	// deepCopyArguments indicates that arguments should be deep copied before
+	// they are recorded in the call history.
+	deepCopyArguments bool
+
+	// interfaceAssertions indicates that the generated file should fail to
+	// compile if the mock no longer implements the source interface.
+	interfaceAssertions bool
+
+	// trackConcurrency indicates that mock functions should count the invocations
+	// that are currently in flight and the maximum number that were in flight at once.
+	trackConcurrency bool
+
+	// constructorPrefix is the prefix used in the names of generated constructors.
+	constructorPrefix string
+
+	// generatedMocks maps the qualified name of each interface mocked in the same
+	// output to the name of its mock struct. Methods returning one of these interfaces
+	// return a nested mock by default. A nil map disables nested mocks.
+	generatedMocks map[string]string
+
+	// defaults maps normalized type expressions to the expressions of the values
+	// returned for results of that type by the noop functions of the constructors.
+	defaults map[string]string
+
+	// emptyCollections indicates that noop functions should return non-nil empty
+	// slices and maps for results without a configured default value.
+	emptyCollections bool
+
+	// testConstructors indicates that constructors bound to a testing.TB value, which
+	// report failures to the test instead of panicking, should be generated.
+	testConstructors bool
+
+	// pendingHooks indicates that mock functions should report the number of pushed
+	// hooks that were never invoked, which are asserted by AssertAllHooksConsumed.
+	pendingHooks bool
+
+	// callSequence indicates that call structs should record the process-wide position
+	// of each invocation among the invocations of all mock functions.
+	callSequence bool
+
+	// resetMethods indicates that mocks and mock functions should support discarding
+	// their configuration and history via Reset, ClearHistory, and ClearHooks.
+	resetMethods bool
+
+	// errorHelpers indicates that mock functions of methods whose final result is an
+	// error should support returning an error and zero values via SetDefaultError,
+	// PushError, and PushErrorN.
+	errorHelpers bool
+
+	// returnSequences indicates that mock functions should support queueing a hook
+	// or return values several times and queueing a sequence of return values.
+	returnSequences bool
+
+	// callInfoHooks indicates that mock functions should support hooks that receive a
+	// description of the invocation, including its position in the history.
+	callInfoHooks bool
+
+	// recordPanics indicates that invocations whose hook panics should be recorded in
+	// the history of the mock function along with the panic value.
+	recordPanics bool
+
+	// randomConstructors indicates that constructors of mocks returning arbitrary values
+	// derived from a seed should be generated.
+	randomConstructors bool
+
+	// blockingHooks indicates that mock functions should support hooks that block the
+	// invocation handling them until the test releases them via a gate.
+	blockingHooks bool
+
+	// zeroValueMocks indicates that the zero value of a mock should be usable in place
+	// of one returned by a constructor.
+	zeroValueMocks bool
+
+	// withConstructors indicates that constructors taking a struct of default hooks and
+	// functional options that modify it should be generated.
+	withConstructors bool
+
+	// conditions indicates that mock functions should support registering hooks for
+	// invocations with matching arguments via When.
+	conditions bool
+
+	// expectations indicates that mock functions should support declaring expected
+	// invocations via Expect, which are verified by the AssertExpectations method.
+	expectations bool
+
+	// faultInjection indicates that mock functions should support injecting latency
+	// and errors into invocations.
+	faultInjection bool
+
+	// recording indicates that constructors recording the invocations of a real
+	// implementation to a fixture file and replaying them should be generated.
+	recording bool
+
+	// subscriptions indicates that mock functions should support waiting for their
+	// invocations via WaitForCalls and streaming them over a channel via Calls.
+	subscriptions bool
 }
 
 func wrapInterface(iface *types.Interface, prefix, titleName, mockStructName, outputImportPath string) *wrappedInterface {
@@ -24,3 +130,134 @@ func wrapInterface(iface *types.Interface, prefix, titleName, mockStructName, ou
 
 	return wrapped
 }
+
+// resultMockName returns the name of the mock struct of the interface returned as
+// the i-th result of the given method, if that interface is mocked in the same output.
+func (iface *wrappedInterface) resultMockName(method *wrappedMethod, i int) (string, bool) {
+	named, ok := gotypes.Unalias(method.Results[i]).(*gotypes.Named)
+	if !ok || named.Obj().Pkg() == nil || named.TypeArgs().Len() != 0 {
+		return "", false
+	}
+	if _, ok := named.Underlying().(*gotypes.Interface); !ok {
+		return "", false
+	}
+
+	name, ok := iface.generatedMocks[named.Obj().Pkg().Path()+"."+named.Obj().Name()]
+	return name, ok
+}
+
+// returnsMocks returns true if any result of the given method is an interface
+// mocked in the same output.
+func (iface *wrappedInterface) returnsMocks(method *wrappedMethod) bool {
+	for i := range method.Results {
+		if _, ok := iface.resultMockName(method, i); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// resetMethodName returns the name of the method that resets all methods of the mock.
+// The method is named ResetMock if the interface itself declares a Reset method.
+func (iface *wrappedInterface) resetMethodName() string {
+	for _, method := range iface.wrappedMethods {
+		if method.Name == "Reset" {
+			return "ResetMock"
+		}
+	}
+
+	return "Reset"
+}
+
+// reservedName is the name of a method or field declared on the mock struct in addition
+// to the methods of the interface, along with the flag that declares it.
+type reservedName struct {
+	name string
+	flag string
+}
+
+// reservedNames returns the names of the methods and fields declared on the mock struct
+// by the enabled flags.
+func (iface *wrappedInterface) reservedNames() []reservedName {
+	var names []reservedName
+	if iface.expectations {
+		names = append(names, reservedName{name: "AssertExpectations", flag: "expectations"})
+	}
+	if iface.pendingHooks {
+		names = append(names, reservedName{name: "AssertAllHooksConsumed", flag: "pending-hooks"})
+	}
+	if iface.resetMethods {
+		names = append(names, reservedName{name: iface.resetMethodName(), flag: "reset-methods"})
+	}
+	if iface.initsFuncs() {
+		names = append(names, reservedName{name: "initFuncs", flag: "zero-value-mocks"})
+		names = append(names, reservedName{name: "mutex", flag: "zero-value-mocks"})
+	}
+	if iface.zeroValueMocks {
+		for _, method := range iface.wrappedMethods {
+			names = append(names, reservedName{name: fmt.Sprintf("Get%sFunc", method.Name), flag: "zero-value-mocks"})
+		}
+	}
+
+	return names
+}
+
+// checkReservedNames returns an error if a method of the interface has the same name as
+// a method or field declared on the mock struct by one of the enabled flags, in which
+// case the generated code would not compile.
+func (iface *wrappedInterface) checkReservedNames() error {
+	for _, reserved := range iface.reservedNames() {
+		for _, method := range iface.wrappedMethods {
+			if method.Name != reserved.name {
+				continue
+			}
+
+			return errorWithSolutions{
+				err: fmt.Errorf("method %s of interface %s conflicts with the %s declared on %s by the %s flag", method.Name, iface.Name, reserved.name, iface.mockStructName, reserved.flag),
+				solutions: []string{
+					fmt.Sprintf("disable the %s flag for interface %s", reserved.flag, iface.Name),
+					fmt.Sprintf("rename method %s of interface %s", method.Name, iface.Name),
+				},
+			}
+		}
+	}
+
+	return nil
+}
+
+// returnsSelf returns true if any result of the given method is the mocked interface
+// itself, in which case the mock is returned when no hook handles an invocation.
+func (iface *wrappedInterface) returnsSelf(method *wrappedMethod) bool {
+	for i := range method.Results {
+		if name, ok := iface.resultMockName(method, i); ok && name == iface.mockStructName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// initsFuncs returns true if the mock function objects of a mock are created on first
+// use, which requires zero-value mocks and at least one method.
+func (iface *wrappedInterface) initsFuncs() bool {
+	return iface.zeroValueMocks && len(iface.wrappedMethods) != 0
+}
+
+// injectsErrors returns true if errors can be injected into invocations of the
+// given method, which requires fault injection and a final result of type error.
+func (iface *wrappedInterface) injectsErrors(method *wrappedMethod) bool {
+	return iface.faultInjection && method.returnsError()
+}
+
+// keyedCalls returns true if call structs declare unexported fields in addition to
+// the arguments and results of the invocation, in which case call literals are keyed.
+func (iface *wrappedInterface) keyedCalls() bool {
+	return iface.recordCallMetadata || iface.callSequence || iface.callInfoHooks || iface.recordPanics || iface.faultInjection
+}
+
+// matchesArguments returns true if the arguments of each invocation are matched against
+// the conditions or expectations registered on the mock function.
+func (iface *wrappedInterface) matchesArguments() bool {
+	return iface.conditions || iface.expectations
+}
@@ -1,6 +1,12 @@
 package generation
 
 import (
+	"fmt"
+	gotypes "go/types"
+	"regexp"
+	"unicode"
+	"unicode/utf8"
+
 	"github.com/dave/jennifer/jen"
 	"github.com/derision-test/go-mockgen/v2/internal/mockgen/types"
 )
@@ -12,6 +18,15 @@ type wrappedMethod struct {
 	paramTypes        []jen.Code
 	resultTypes       []jen.Code
 	signature         jen.Code
+
+	// paramNames are the identifiers of the parameters of the generated mock method.
+	paramNames []string
+
+	// argFieldNames are the names of the call struct fields holding each argument.
+	argFieldNames []string
+
+	// namedParams indicates that hook signatures declare the parameter names.
+	namedParams bool
 }
 
 func wrapMethod(iface *types.Interface, method *types.Method, outputImportPath string) *wrappedMethod {
@@ -23,6 +38,11 @@ func wrapMethod(iface *types.Interface, method *types.Method, outputImportPath s
 		resultTypes:       generateResultTypes(method, iface.ImportPath, outputImportPath),
 	}
 
+	for i := range method.Params {
+		m.paramNames = append(m.paramNames, fmt.Sprintf("v%d", i))
+		m.argFieldNames = append(m.argFieldNames, fmt.Sprintf("Arg%d", i))
+	}
+
 	m.signature = jen.Func().Params(m.paramTypes...).Params(m.resultTypes...)
 	return m
 }
@@ -54,3 +74,98 @@ func generateResultTypes(method *types.Method, importPath, outputImportPath stri
 
 	return results
 }
+
+// returnsError returns true if the final result of the method is of type error.
+func (m *wrappedMethod) returnsError() bool {
+	if len(m.Results) == 0 {
+		return false
+	}
+
+	return gotypes.Identical(m.Results[len(m.Results)-1], gotypes.Universe.Lookup("error").Type())
+}
+
+// reservedParamNamePattern matches identifiers declared or referenced by the bodies
+// of generated mock methods, which therefore cannot be used as parameter names.
+var reservedParamNamePattern = regexp.MustCompile(`^(m|info|metadata|fault|sequence|mocksupport|[ar][0-9]+)$`)
+
+// reservedArgFieldNames are the names of the methods of generated call structs.
+var reservedArgFieldNames = []string{"Args", "Results", "Sequence", "PanicValue", "Fault", "Timestamp", "GoroutineID", "Caller"}
+
+// useParameterNames replaces the positional parameter names of the generated mock
+// method and the positional argument fields of its call struct with names derived
+// from the source declaration. Parameters that are unnamed, blank, or would collide
+// with another identifier keep their positional name (suffixed with underscores if
+// necessary). The given names of the interface's type parameters are never used.
+func (m *wrappedMethod) useParameterNames(typeParamNames []string) {
+	reservedParamNames := append([]string(nil), typeParamNames...)
+	reservedFieldNames := append([]string(nil), reservedArgFieldNames...)
+	for i := range m.Results {
+		reservedFieldNames = append(reservedFieldNames, fmt.Sprintf("Result%d", i))
+	}
+
+	sourceNames := make([]string, len(m.Params))
+	fieldNames := make([]string, len(m.Params))
+	copy(sourceNames, m.ParamNames)
+	for i, name := range sourceNames {
+		if name == "_" || reservedParamNamePattern.MatchString(name) {
+			sourceNames[i] = ""
+		}
+
+		if r, size := utf8.DecodeRuneInString(name); unicode.IsUpper(unicode.ToUpper(r)) {
+			fieldNames[i] = string(unicode.ToUpper(r)) + name[size:]
+		}
+	}
+
+	m.paramNames = assignNames(sourceNames, reservedParamNames, func(i int) string { return fmt.Sprintf("v%d", i) })
+	m.argFieldNames = assignNames(fieldNames, reservedFieldNames, func(i int) string { return fmt.Sprintf("Arg%d", i) })
+	m.namedParams = true
+	m.signature = jen.Func().Params(m.namedParamTypes()...).Params(m.resultTypes...)
+}
+
+// namedParamTypes returns the parameters of the method each preceded by its name.
+func (m *wrappedMethod) namedParamTypes() []jen.Code {
+	params := make([]jen.Code, 0, len(m.paramTypes))
+	for i, param := range m.paramTypes {
+		params = append(params, compose(jen.Id(m.paramNames[i]), param))
+	}
+
+	return params
+}
+
+// assignNames returns a distinct name for each of the given candidates. Non-empty
+// candidates that are not reserved are kept as-is, in order of appearance. All other
+// positions are given the fallback name for that position, which is suffixed with
+// underscores until it is distinct.
+func assignNames(candidates, reserved []string, fallback func(i int) string) []string {
+	used := make(map[string]struct{}, len(candidates)+len(reserved))
+	for _, name := range reserved {
+		used[name] = struct{}{}
+	}
+
+	names := make([]string, len(candidates))
+	for i, name := range candidates {
+		if _, ok := used[name]; name != "" && !ok {
+			names[i] = name
+			used[name] = struct{}{}
+		}
+	}
+
+	for i, name := range names {
+		if name != "" {
+			continue
+		}
+
+		name = fallback(i)
+		for {
+			if _, ok := used[name]; !ok {
+				break
+			}
+			name += "_"
+		}
+
+		names[i] = name
+		used[name] = struct{}{}
+	}
+
+	return names
+}
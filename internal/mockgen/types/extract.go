@@ -14,10 +14,11 @@ import (
 )
 
 type PackageOptions struct {
-	ImportPaths []string
-	Interfaces  []string
-	Exclude     []string
-	Prefix      string
+	ImportPaths      []string
+	Interfaces       []string
+	Exclude          []string
+	Prefix           string
+	DeclarationOrder bool
 }
 
 func Extract(pkgs []*packages.Package, packageOptions []PackageOptions) (ifaces []*Interface, _ error) {
@@ -27,7 +28,7 @@ func Extract(pkgs []*packages.Package, packageOptions []PackageOptions) (ifaces
 	}
 
 	for _, packageOpts := range packageOptions {
-		packageTypes, err := gatherAllPackageTypes(pkgs, workingDirectory, packageOpts.ImportPaths)
+		packageTypes, err := gatherAllPackageTypes(pkgs, workingDirectory, packageOpts.ImportPaths, packageOpts.DeclarationOrder)
 		if err != nil {
 			return nil, err
 		}
@@ -48,13 +49,13 @@ func Extract(pkgs []*packages.Package, packageOptions []PackageOptions) (ifaces
 	return ifaces, nil
 }
 
-func gatherAllPackageTypes(pkgs []*packages.Package, workingDirectory string, importPaths []string) (map[string]map[string]*Interface, error) {
+func gatherAllPackageTypes(pkgs []*packages.Package, workingDirectory string, importPaths []string, declarationOrder bool) (map[string]map[string]*Interface, error) {
 	packageTypes := make(map[string]map[string]*Interface, len(importPaths))
 	for _, importPath := range importPaths {
 		path, dir := paths.ResolveImportPath(workingDirectory, importPath)
 		log.Printf("parsing package '%s'\n", paths.GetRelativePath(dir))
 
-		types, err := gatherTypesForPackage(pkgs, importPath, path)
+		types, err := gatherTypesForPackage(pkgs, importPath, path, declarationOrder)
 		if err != nil {
 			return nil, err
 		}
@@ -65,7 +66,7 @@ func gatherAllPackageTypes(pkgs []*packages.Package, workingDirectory string, im
 	return packageTypes, nil
 }
 
-func gatherTypesForPackage(pkgs []*packages.Package, importPath, path string) (map[string]*Interface, error) {
+func gatherTypesForPackage(pkgs []*packages.Package, importPath, path string, declarationOrder bool) (map[string]*Interface, error) {
 	for _, pkg := range pkgs {
 		if pkg.PkgPath != path {
 			continue
@@ -80,7 +81,7 @@ func gatherTypesForPackage(pkgs []*packages.Package, importPath, path string) (m
 			}
 		}
 
-		visitor := newVisitor(path, pkg.Types)
+		visitor := newVisitor(path, pkg.Types, declarationOrder)
 		for _, file := range pkg.Syntax {
 			ast.Walk(visitor, file)
 		}
@@ -21,7 +21,7 @@ type TypeParam struct {
 	Type types.Type
 }
 
-func newInterfaceFromTypeSpec(name, importPath string, typeSpec *ast.TypeSpec, underlyingType *types.Interface, ps *types.TypeParamList) *Interface {
+func newInterfaceFromTypeSpec(name, importPath string, typeSpec *ast.TypeSpec, underlyingType *types.Interface, ps *types.TypeParamList, declarationOrder bool) *Interface {
 	methodMap := make(map[string]*Method, underlyingType.NumMethods())
 	for i := 0; i < underlyingType.NumMethods(); i++ {
 		method := underlyingType.Method(i)
@@ -29,11 +29,10 @@ func newInterfaceFromTypeSpec(name, importPath string, typeSpec *ast.TypeSpec, u
 		methodMap[name] = newMethodFromSignature(name, method.Type().(*types.Signature))
 	}
 
-	methodNames := make([]string, 0, len(methodMap))
-	for k := range methodMap {
-		methodNames = append(methodNames, k)
+	methodNames := sortedMethodNames(underlyingType)
+	if declarationOrder {
+		methodNames = declaredMethodNames(typeSpec, underlyingType)
 	}
-	sort.Strings(methodNames)
 
 	methods := make([]*Method, 0, len(methodNames))
 	for _, name := range methodNames {
@@ -56,3 +55,97 @@ func newInterfaceFromTypeSpec(name, importPath string, typeSpec *ast.TypeSpec, u
 		Methods:    methods,
 	}
 }
+
+// sortedMethodNames returns the names of all methods of the given interface in
+// alphabetical order.
+func sortedMethodNames(underlyingType *types.Interface) []string {
+	methodNames := make([]string, 0, underlyingType.NumMethods())
+	for i := 0; i < underlyingType.NumMethods(); i++ {
+		methodNames = append(methodNames, underlyingType.Method(i).Name())
+	}
+	sort.Strings(methodNames)
+
+	return methodNames
+}
+
+// declaredMethodNames returns the names of all methods of the given interface in the
+// order in which they are written in the given type spec. The methods of an embedded
+// interface are grouped together at the position of the embedding. Methods promoted
+// from more than one source are listed only at their first occurrence.
+func declaredMethodNames(typeSpec *ast.TypeSpec, underlyingType *types.Interface) []string {
+	interfaceType, ok := typeSpec.Type.(*ast.InterfaceType)
+	if !ok {
+		// Declared in terms of another interface type; no syntax to follow
+		return dedupeMethodNames(positionalMethodNames(underlyingType), underlyingType)
+	}
+
+	var methodNames []string
+	embeddedIndex := 0
+	for _, field := range interfaceType.Methods.List {
+		if len(field.Names) > 0 {
+			for _, name := range field.Names {
+				methodNames = append(methodNames, name.Name)
+			}
+
+			continue
+		}
+
+		// Embedded types are recorded by the type checker in source order
+		if embeddedIndex < underlyingType.NumEmbeddeds() {
+			methodNames = append(methodNames, embeddedMethodNames(underlyingType.EmbeddedType(embeddedIndex))...)
+		}
+		embeddedIndex++
+	}
+
+	return dedupeMethodNames(methodNames, underlyingType)
+}
+
+// positionalMethodNames returns the names of the methods of the given interface for
+// which no syntax is available. Explicitly declared methods are ordered by their source
+// position and are followed by the methods of each embedded interface, in order.
+func positionalMethodNames(underlyingType *types.Interface) []string {
+	explicitMethods := make([]*types.Func, 0, underlyingType.NumExplicitMethods())
+	for i := 0; i < underlyingType.NumExplicitMethods(); i++ {
+		explicitMethods = append(explicitMethods, underlyingType.ExplicitMethod(i))
+	}
+	sort.SliceStable(explicitMethods, func(i, j int) bool { return explicitMethods[i].Pos() < explicitMethods[j].Pos() })
+
+	methodNames := make([]string, 0, underlyingType.NumMethods())
+	for _, method := range explicitMethods {
+		methodNames = append(methodNames, method.Name())
+	}
+	for i := 0; i < underlyingType.NumEmbeddeds(); i++ {
+		methodNames = append(methodNames, embeddedMethodNames(underlyingType.EmbeddedType(i))...)
+	}
+
+	return methodNames
+}
+
+// embeddedMethodNames returns the names of the methods contributed by the given embedded
+// type. Embedded elements that are not interfaces (e.g., type unions) contribute none.
+func embeddedMethodNames(embeddedType types.Type) []string {
+	underlyingType, ok := embeddedType.Underlying().(*types.Interface)
+	if !ok {
+		return nil
+	}
+
+	return positionalMethodNames(underlyingType)
+}
+
+// dedupeMethodNames removes repeated names from the given list, keeping the first
+// occurrence. Any method of the given interface missing from the list is appended in
+// alphabetical order so that the result always describes the complete method set.
+func dedupeMethodNames(methodNames []string, underlyingType *types.Interface) []string {
+	seen := make(map[string]struct{}, len(methodNames))
+	deduped := make([]string, 0, underlyingType.NumMethods())
+	for _, name := range append(methodNames, sortedMethodNames(underlyingType)...) {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+
+		seen[name] = struct{}{}
+		deduped = append(deduped, name)
+	}
+
+	return deduped
+}
@@ -3,18 +3,21 @@ package types
 import "go/types"
 
 type Method struct {
-	Name     string
-	Params   []types.Type
-	Results  []types.Type
-	Variadic bool
+	Name       string
+	Params     []types.Type
+	ParamNames []string
+	Results    []types.Type
+	Variadic   bool
 }
 
 func newMethodFromSignature(name string, signature *types.Signature) *Method {
 	ps := signature.Params()
 	pn := ps.Len()
 	params := make([]types.Type, 0, pn)
+	paramNames := make([]string, 0, pn)
 	for i := 0; i < pn; i++ {
 		params = append(params, ps.At(i).Type())
+		paramNames = append(paramNames, ps.At(i).Name())
 	}
 
 	rs := signature.Results()
@@ -25,9 +28,10 @@ func newMethodFromSignature(name string, signature *types.Signature) *Method {
 	}
 
 	return &Method{
-		Name:     name,
-		Params:   params,
-		Results:  results,
-		Variadic: signature.Variadic(),
+		Name:       name,
+		Params:     params,
+		ParamNames: paramNames,
+		Results:    results,
+		Variadic:   signature.Variadic(),
 	}
 }
@@ -7,16 +7,18 @@ import (
 )
 
 type visitor struct {
-	importPath string
-	pkgType    *types.Package
-	types      map[string]*Interface
+	importPath       string
+	pkgType          *types.Package
+	declarationOrder bool
+	types            map[string]*Interface
 }
 
-func newVisitor(importPath string, pkgType *types.Package) *visitor {
+func newVisitor(importPath string, pkgType *types.Package, declarationOrder bool) *visitor {
 	return &visitor{
-		importPath: importPath,
-		pkgType:    pkgType,
-		types:      map[string]*Interface{},
+		importPath:       importPath,
+		pkgType:          pkgType,
+		declarationOrder: declarationOrder,
+		types:            map[string]*Interface{},
 	}
 }
 
@@ -45,7 +47,7 @@ func (v *visitor) Visit(node ast.Node) ast.Visitor {
 						continue
 					}
 
-					v.types[name] = newInterfaceFromTypeSpec(name, v.importPath, typeSpec, t, namedType.TypeParams())
+					v.types[name] = newInterfaceFromTypeSpec(name, v.importPath, typeSpec, t, namedType.TypeParams(), v.declarationOrder)
 				}
 			}
 		}
@@ -0,0 +1,50 @@
+package testutil
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// CallMetadataInstance is a call instance that records the time, goroutine, and caller
+// location of the invocation it describes.
+type CallMetadataInstance interface {
+	Timestamp() time.Time
+	GoroutineID() uint64
+	Caller() string
+}
+
+// DescribeCalls returns a description of the invocations described by the given value, which
+// may be either a mock function or a single call instance, suitable for appending to a test
+// failure message. Only invocations that record call metadata are described; an empty string
+// is returned if there are no such invocations.
+func DescribeCalls(v interface{}) string {
+	var calls []CallInstance
+	if call, ok := v.(CallInstance); ok {
+		calls = []CallInstance{call}
+	} else if history, ok := GetCallHistory(v); ok {
+		calls = history
+	}
+
+	var descriptions []string
+	for _, call := range calls {
+		metadata, ok := call.(CallMetadataInstance)
+		if !ok {
+			continue
+		}
+
+		descriptions = append(descriptions, fmt.Sprintf(
+			"%v at %s on goroutine %d (%s)",
+			call.Args(),
+			metadata.Caller(),
+			metadata.GoroutineID(),
+			metadata.Timestamp().Format(time.RFC3339Nano),
+		))
+	}
+
+	if len(descriptions) == 0 {
+		return ""
+	}
+
+	return "\nRecorded invocations:\n  - " + strings.Join(descriptions, "\n  - ")
+}
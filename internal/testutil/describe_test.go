@@ -0,0 +1,49 @@
+package testutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeCalls(t *testing.T) {
+	timestamp := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	value := &metadataHistory{history: []metadataCall{
+		{mockCall: mockCall{args: []interface{}{"foo", 1}}, timestamp: timestamp, goroutineID: 7, caller: "foo.go:12"},
+		{mockCall: mockCall{args: []interface{}{"bar", 2}}, timestamp: timestamp, goroutineID: 8, caller: "bar.go:34"},
+	}}
+
+	assert.Equal(t, ""+
+		"\nRecorded invocations:"+
+		"\n  - [foo 1] at foo.go:12 on goroutine 7 (2024-01-02T03:04:05Z)"+
+		"\n  - [bar 2] at bar.go:34 on goroutine 8 (2024-01-02T03:04:05Z)",
+		DescribeCalls(value),
+	)
+}
+
+func TestDescribeCallsCallInstance(t *testing.T) {
+	call := metadataCall{mockCall: mockCall{args: []interface{}{"foo"}}, goroutineID: 7, caller: "foo.go:12"}
+	assert.Contains(t, DescribeCalls(call), "[foo] at foo.go:12 on goroutine 7")
+}
+
+func TestDescribeCallsWithoutMetadata(t *testing.T) {
+	assert.Equal(t, "", DescribeCalls(newHistory(mockCall{args: []interface{}{"foo"}})))
+	assert.Equal(t, "", DescribeCalls(nil))
+}
+
+type metadataHistory struct {
+	history []metadataCall
+}
+
+type metadataCall struct {
+	mockCall
+	timestamp   time.Time
+	goroutineID uint64
+	caller      string
+}
+
+func (m *metadataHistory) History() []metadataCall { return m.history }
+func (m metadataCall) Timestamp() time.Time        { return m.timestamp }
+func (m metadataCall) GoroutineID() uint64         { return m.goroutineID }
+func (m metadataCall) Caller() string              { return m.caller }
@@ -1,12 +1,15 @@
 package testutil
 
 type mockFunc struct {
-	history []mockCall
+	history        []mockCall
+	pending        int
+	maxConcurrency int
 }
 
 type mockCall struct {
-	args    []interface{}
-	results []interface{}
+	args     []interface{}
+	results  []interface{}
+	sequence uint64
 }
 
 func newHistory(calls ...mockCall) *mockFunc {
@@ -16,5 +19,8 @@ func newHistory(calls ...mockCall) *mockFunc {
 }
 
 func (m mockFunc) History() []mockCall    { return m.history }
+func (m mockFunc) PendingHooks() int      { return m.pending }
+func (m mockFunc) MaxConcurrency() int    { return m.maxConcurrency }
 func (m mockCall) Args() []interface{}    { return m.args }
 func (m mockCall) Results() []interface{} { return m.results }
+func (m mockCall) Sequence() uint64       { return m.sequence }
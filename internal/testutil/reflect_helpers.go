@@ -1,6 +1,9 @@
 package testutil
 
-import "reflect"
+import (
+	"reflect"
+	"sort"
+)
 
 // CallInstance holds the arguments and results of a single mock function call.
 type CallInstance interface {
@@ -8,6 +11,12 @@ type CallInstance interface {
 	Results() []interface{}
 }
 
+// SequencedCallInstance is a call instance that records its position among the invocations
+// of all mock functions.
+type SequencedCallInstance interface {
+	Sequence() uint64
+}
+
 // GetCallHistory extracts the history from the given mock function and returns the
 // set of call instances.
 func GetCallHistory(v interface{}) ([]CallInstance, bool) {
@@ -91,3 +100,93 @@ func GetArgs(v interface{}) ([]interface{}, bool) {
 	// Return result unchanged
 	return args.Interface().([]interface{}), true
 }
+
+// GetPendingHooks returns the number of pushed hooks of the given mock function that
+// have not yet been invoked. If the given parameter is not of the required type, a
+// false-valued flag is returned.
+func GetPendingHooks(v interface{}) (int, bool) {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return 0, false
+	}
+
+	// Get reflect value of method
+	method := value.MethodByName("PendingHooks")
+	if !method.IsValid() {
+		return 0, false
+	}
+
+	// Check method arity
+	if method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return 0, false
+	}
+
+	// Invoke the function with no arguments and get the reflect.Value result
+	pending := method.Call(nil)[0]
+
+	// Ensure the returned type is int
+	if pending.Kind() != reflect.Int {
+		return 0, false
+	}
+
+	return int(pending.Int()), true
+}
+
+// GetMaxConcurrency returns the maximum number of invocations of the given mock function
+// that were in flight at once. If the given parameter is not of the required type, a
+// false-valued flag is returned.
+func GetMaxConcurrency(v interface{}) (int, bool) {
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return 0, false
+	}
+
+	// Get reflect value of method
+	method := value.MethodByName("MaxConcurrency")
+	if !method.IsValid() {
+		return 0, false
+	}
+
+	// Check method arity
+	if method.Type().NumIn() != 0 || method.Type().NumOut() != 1 {
+		return 0, false
+	}
+
+	// Invoke the function with no arguments and get the reflect.Value result
+	maxConcurrency := method.Call(nil)[0]
+
+	// Ensure the returned type is int
+	if maxConcurrency.Kind() != reflect.Int {
+		return 0, false
+	}
+
+	return int(maxConcurrency.Int()), true
+}
+
+// GetCallSequences returns the sequence numbers of the invocations described by the given
+// value in ascending order. The value may be either a mock function, in which case all of
+// its recorded invocations are returned, or a single call instance. If the given parameter
+// is not of the required type, a false-valued flag is returned.
+func GetCallSequences(v interface{}) ([]uint64, bool) {
+	if call, ok := v.(SequencedCallInstance); ok {
+		return []uint64{call.Sequence()}, true
+	}
+
+	history, ok := GetCallHistory(v)
+	if !ok {
+		return nil, false
+	}
+
+	sequences := make([]uint64, 0, len(history))
+	for _, call := range history {
+		sequencedCall, ok := call.(SequencedCallInstance)
+		if !ok {
+			return nil, false
+		}
+
+		sequences = append(sequences, sequencedCall.Sequence())
+	}
+
+	sort.Slice(sequences, func(i, j int) bool { return sequences[i] < sequences[j] })
+	return sequences, true
+}
@@ -148,3 +148,103 @@ type argsFuncBadSliceTypes struct{}
 func (m *argsFuncBadSliceTypes) Args() []string {
 	return nil
 }
+
+func TestGetPendingHooks(t *testing.T) {
+	pending, ok := GetPendingHooks(&mockFunc{pending: 3})
+	assert.True(t, ok)
+	assert.Equal(t, 3, pending)
+}
+
+func TestGetPendingHooksNil(t *testing.T) {
+	_, ok := GetPendingHooks(nil)
+	assert.False(t, ok)
+}
+
+func TestGetPendingHooksNoPendingHooksMethod(t *testing.T) {
+	_, ok := GetPendingHooks(struct{}{})
+	assert.False(t, ok)
+}
+
+func TestGetPendingHooksBadParamArity(t *testing.T) {
+	_, ok := GetPendingHooks(&pendingHooksFuncBadParamArity{})
+	assert.False(t, ok)
+}
+
+type pendingHooksFuncBadParamArity struct{}
+
+func (m *pendingHooksFuncBadParamArity) PendingHooks(n int) int {
+	return 0
+}
+
+func TestGetPendingHooksNonIntResult(t *testing.T) {
+	_, ok := GetPendingHooks(&pendingHooksFuncNonIntResult{})
+	assert.False(t, ok)
+}
+
+type pendingHooksFuncNonIntResult struct{}
+
+func (m *pendingHooksFuncNonIntResult) PendingHooks() string {
+	return ""
+}
+
+func TestGetMaxConcurrency(t *testing.T) {
+	maxConcurrency, ok := GetMaxConcurrency(&mockFunc{maxConcurrency: 4})
+	assert.True(t, ok)
+	assert.Equal(t, 4, maxConcurrency)
+}
+
+func TestGetMaxConcurrencyNil(t *testing.T) {
+	_, ok := GetMaxConcurrency(nil)
+	assert.False(t, ok)
+}
+
+func TestGetMaxConcurrencyNoMaxConcurrencyMethod(t *testing.T) {
+	_, ok := GetMaxConcurrency(struct{}{})
+	assert.False(t, ok)
+}
+
+func TestGetMaxConcurrencyNonIntResult(t *testing.T) {
+	_, ok := GetMaxConcurrency(&maxConcurrencyFuncNonIntResult{})
+	assert.False(t, ok)
+}
+
+type maxConcurrencyFuncNonIntResult struct{}
+
+func (m *maxConcurrencyFuncNonIntResult) MaxConcurrency() string {
+	return ""
+}
+
+func TestGetCallSequences(t *testing.T) {
+	value := newHistory(
+		mockCall{sequence: 4},
+		mockCall{sequence: 2},
+		mockCall{sequence: 7},
+	)
+
+	sequences, ok := GetCallSequences(value)
+	assert.True(t, ok)
+	assert.Equal(t, []uint64{2, 4, 7}, sequences)
+}
+
+func TestGetCallSequencesCallInstance(t *testing.T) {
+	sequences, ok := GetCallSequences(mockCall{sequence: 3})
+	assert.True(t, ok)
+	assert.Equal(t, []uint64{3}, sequences)
+}
+
+func TestGetCallSequencesNil(t *testing.T) {
+	_, ok := GetCallSequences(nil)
+	assert.False(t, ok)
+}
+
+func TestGetCallSequencesUnsequencedHistory(t *testing.T) {
+	_, ok := GetCallSequences(&unsequencedHistory{})
+	assert.False(t, ok)
+}
+
+type unsequencedHistory struct{}
+type unsequencedCall struct{}
+
+func (h *unsequencedHistory) History() []unsequencedCall { return []unsequencedCall{{}} }
+func (c unsequencedCall) Args() []interface{}            { return nil }
+func (c unsequencedCall) Results() []interface{}         { return nil }
@@ -14,7 +14,7 @@ func Called(t assert.TestingT, mockFn interface{}, msgAndArgs ...interface{}) bo
 		return false
 	}
 	if callCount == 0 {
-		return assert.Fail(t, fmt.Sprintf("Expected %T to be called at least once", mockFn), msgAndArgs...)
+		return assert.Fail(t, fmt.Sprintf("Expected %T to be called at least once%s", mockFn, testutil.DescribeCalls(mockFn)), msgAndArgs...)
 	}
 
 	return true
@@ -27,7 +27,7 @@ func NotCalled(t assert.TestingT, mockFn interface{}, msgAndArgs ...interface{})
 		return false
 	}
 	if callCount != 0 {
-		return assert.Fail(t, fmt.Sprintf("Did not expect %T to be called", mockFn), msgAndArgs...)
+		return assert.Fail(t, fmt.Sprintf("Did not expect %T to be called%s", mockFn, testutil.DescribeCalls(mockFn)), msgAndArgs...)
 	}
 
 	return true
@@ -45,7 +45,7 @@ func CalledN(t assert.TestingT, mockFn interface{}, n int, msgAndArgs ...interfa
 		return false
 	}
 	if callCount != n {
-		return assert.Fail(t, fmt.Sprintf("Expected %T to be called exactly %d times, called %d times", mockFn, n, callCount), msgAndArgs...)
+		return assert.Fail(t, fmt.Sprintf("Expected %T to be called exactly %d times, called %d times%s", mockFn, n, callCount, testutil.DescribeCalls(mockFn)), msgAndArgs...)
 	}
 
 	return true
@@ -59,7 +59,7 @@ func CalledWith(t assert.TestingT, mockFn interface{}, asserter CallInstanceAsse
 		return false
 	}
 	if matchingCallCount == 0 {
-		return assert.Fail(t, fmt.Sprintf("Expected %T to be called with given arguments at least once", mockFn), msgAndArgs...)
+		return assert.Fail(t, fmt.Sprintf("Expected %T to be called with given arguments at least once%s", mockFn, testutil.DescribeCalls(mockFn)), msgAndArgs...)
 	}
 	return true
 }
@@ -72,7 +72,7 @@ func NotCalledWith(t assert.TestingT, mockFn interface{}, asserter CallInstanceA
 		return false
 	}
 	if matchingCallCount != 0 {
-		return assert.Fail(t, fmt.Sprintf("Did not expect %T to be called with given arguments", mockFn), msgAndArgs...)
+		return assert.Fail(t, fmt.Sprintf("Did not expect %T to be called with given arguments%s", mockFn, testutil.DescribeCalls(mockFn)), msgAndArgs...)
 	}
 	return true
 }
@@ -91,7 +91,7 @@ func CalledNWith(t assert.TestingT, mockFn interface{}, n int, asserter CallInst
 		return false
 	}
 	if matchingCallCount != n {
-		return assert.Fail(t, fmt.Sprintf("Expected %T to be called with given arguments exactly %d times, called %d times", mockFn, n, matchingCallCount), msgAndArgs...)
+		return assert.Fail(t, fmt.Sprintf("Expected %T to be called with given arguments exactly %d times, called %d times%s", mockFn, n, matchingCallCount, testutil.DescribeCalls(mockFn)), msgAndArgs...)
 	}
 	return true
 }
@@ -104,11 +104,81 @@ func CalledAtNWith(t assert.TestingT, mockFn interface{}, n int, asserter CallIn
 		return false
 	}
 	if len(hist) < n {
-		return assert.Fail(t, fmt.Sprintf("Expected %T to be called at least %d times, called %d times", mockFn, n, len(hist)), msgAndArgs...)
+		return assert.Fail(t, fmt.Sprintf("Expected %T to be called at least %d times, called %d times%s", mockFn, n, len(hist), testutil.DescribeCalls(mockFn)), msgAndArgs...)
 	}
 
 	if !asserter.Assert(hist[n]) {
-		return assert.Fail(t, fmt.Sprintf("Expected call %d of %T to be with given arguments", n, mockFn), msgAndArgs...)
+		return assert.Fail(t, fmt.Sprintf("Expected call %d of %T to be with given arguments%s", n, mockFn, testutil.DescribeCalls(hist[n])), msgAndArgs...)
+	}
+
+	return true
+}
+
+// HooksConsumed asserts that the mock function object has invoked every hook and return
+// value pushed onto its queue.
+func HooksConsumed(t assert.TestingT, mockFn interface{}, msgAndArgs ...interface{}) bool {
+	pending, ok := testutil.GetPendingHooks(mockFn)
+	if !ok {
+		return assert.Fail(t, fmt.Sprintf("Parameters must be a mock function description, got %T", mockFn), msgAndArgs...)
+	}
+	if pending != 0 {
+		return assert.Fail(t, fmt.Sprintf("Expected %T to have consumed all hooks, %d hooks are pending", mockFn, pending), msgAndArgs...)
+	}
+
+	return true
+}
+
+// MaxConcurrentCalls asserts that no more than n invocations of the mock function object
+// were in flight at once. The mock must be generated with concurrency tracking enabled.
+func MaxConcurrentCalls(t assert.TestingT, mockFn interface{}, n int, msgAndArgs ...interface{}) bool {
+	maxConcurrency, ok := testutil.GetMaxConcurrency(mockFn)
+	if !ok {
+		return assert.Fail(t, fmt.Sprintf("Parameters must be a mock function description with concurrency tracking, got %T", mockFn), msgAndArgs...)
+	}
+	if maxConcurrency > n {
+		return assert.Fail(t, fmt.Sprintf("Expected %T to be called at most %d times concurrently, called %d times concurrently", mockFn, n, maxConcurrency), msgAndArgs...)
+	}
+
+	return true
+}
+
+// MinConcurrentCalls asserts that at least n invocations of the mock function object were
+// in flight at once. The mock must be generated with concurrency tracking enabled.
+func MinConcurrentCalls(t assert.TestingT, mockFn interface{}, n int, msgAndArgs ...interface{}) bool {
+	maxConcurrency, ok := testutil.GetMaxConcurrency(mockFn)
+	if !ok {
+		return assert.Fail(t, fmt.Sprintf("Parameters must be a mock function description with concurrency tracking, got %T", mockFn), msgAndArgs...)
+	}
+	if maxConcurrency < n {
+		return assert.Fail(t, fmt.Sprintf("Expected %T to be called at least %d times concurrently, called %d times concurrently", mockFn, n, maxConcurrency), msgAndArgs...)
+	}
+
+	return true
+}
+
+// InOrder asserts that the given mock function objects were called in the given order. Each
+// value may be a mock function, in which case all of its invocations must occur after every
+// invocation described by the previous value, or a single call instance taken from a mock
+// function's history. Values may belong to different methods and different mock instances.
+// The mocks must be generated with the call-sequence flag.
+func InOrder(t assert.TestingT, mockFns []interface{}, msgAndArgs ...interface{}) bool {
+	var previous interface{}
+	var previousLast uint64
+
+	for _, mockFn := range mockFns {
+		sequences, ok := testutil.GetCallSequences(mockFn)
+		if !ok {
+			return assert.Fail(t, fmt.Sprintf("Parameters must be a mock function description or call instance, got %T", mockFn), msgAndArgs...)
+		}
+		if len(sequences) == 0 {
+			return assert.Fail(t, fmt.Sprintf("Expected %T to be called at least once", mockFn), msgAndArgs...)
+		}
+		if previous != nil && sequences[0] < previousLast {
+			return assert.Fail(t, fmt.Sprintf("Expected %T to be called before %T%s%s", previous, mockFn, testutil.DescribeCalls(previous), testutil.DescribeCalls(mockFn)), msgAndArgs...)
+		}
+
+		previous = mockFn
+		previousLast = sequences[len(sequences)-1]
 	}
 
 	return true
@@ -0,0 +1,54 @@
+package matchers
+
+import (
+	"fmt"
+
+	"github.com/derision-test/go-mockgen/v2/internal/testutil"
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/types"
+)
+
+type calledBeforeMatcher struct {
+	name  string
+	other interface{}
+}
+
+var _ types.GomegaMatcher = &calledBeforeMatcher{}
+
+// BeCalledBefore constructs a matcher that asserts every invocation of the mock function
+// object occurred before any invocation of the given mock function object. Either value may
+// also be a single call instance taken from a mock function's history, and the two values
+// may belong to different mock instances. Both values must have been invoked at least once,
+// and the mocks must be generated with the call-sequence flag.
+func BeCalledBefore(other interface{}) types.GomegaMatcher {
+	return &calledBeforeMatcher{
+		name:  "BeCalledBefore",
+		other: other,
+	}
+}
+
+func (m *calledBeforeMatcher) Match(actual interface{}) (bool, error) {
+	sequences, ok := testutil.GetCallSequences(actual)
+	if !ok {
+		return false, fmt.Errorf("%s expects a mock function description. Got:\n%s", m.name, format.Object(actual, 1))
+	}
+
+	otherSequences, ok := testutil.GetCallSequences(m.other)
+	if !ok {
+		return false, fmt.Errorf("%s expects a mock function description. Got:\n%s", m.name, format.Object(m.other, 1))
+	}
+
+	if len(sequences) == 0 || len(otherSequences) == 0 {
+		return false, nil
+	}
+
+	return sequences[len(sequences)-1] < otherSequences[0], nil
+}
+
+func (m *calledBeforeMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n%s\nto be called before\n%s%s%s", format.Object(actual, 1), format.Object(m.other, 1), testutil.DescribeCalls(actual), testutil.DescribeCalls(m.other))
+}
+
+func (m *calledBeforeMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n%s\nnot to be called before\n%s%s%s", format.Object(actual, 1), format.Object(m.other, 1), testutil.DescribeCalls(actual), testutil.DescribeCalls(m.other))
+}
@@ -0,0 +1,50 @@
+package matchers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCalledBeforeMatch(t *testing.T) {
+	first := newSequencedHistory(1, 2)
+	second := newSequencedHistory(3)
+
+	ok, err := BeCalledBefore(second).Match(first)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestCalledBeforeMatchInterleaved(t *testing.T) {
+	first := newSequencedHistory(1, 3)
+	second := newSequencedHistory(2)
+
+	ok, err := BeCalledBefore(second).Match(first)
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestCalledBeforeMatchCallInstance(t *testing.T) {
+	first := newSequencedHistory(1, 3)
+	second := newSequencedHistory(2)
+
+	ok, err := BeCalledBefore(second).Match(first.history[0])
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestCalledBeforeMatchEmptyHistory(t *testing.T) {
+	ok, err := BeCalledBefore(newSequencedHistory(1)).Match(newSequencedHistory())
+	assert.Nil(t, err)
+	assert.False(t, ok)
+}
+
+func TestCalledBeforeMatchError(t *testing.T) {
+	_, err := BeCalledBefore(newSequencedHistory()).Match(nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "BeCalledBefore expects a mock function")
+
+	_, err = BeCalledBefore(nil).Match(newSequencedHistory())
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "BeCalledBefore expects a mock function")
+}
@@ -31,11 +31,11 @@ func (m *calledMatcher) Match(actual interface{}) (bool, error) {
 }
 
 func (m *calledMatcher) FailureMessage(actual interface{}) string {
-	return fmt.Sprintf("Expected\n%s\nto be called at least once", format.Object(actual, 1))
+	return fmt.Sprintf("Expected\n%s\nto be called at least once%s", format.Object(actual, 1), testutil.DescribeCalls(actual))
 }
 
 func (m *calledMatcher) NegatedFailureMessage(actual interface{}) string {
-	return fmt.Sprintf("Expected\n%s\nnot to be called at least once", format.Object(actual, 1))
+	return fmt.Sprintf("Expected\n%s\nnot to be called at least once%s", format.Object(actual, 1), testutil.DescribeCalls(actual))
 }
 
 // BeCalledOnce constructs a matcher that asserts the mock function object was called exactly once.
@@ -71,9 +71,9 @@ func (m *calledNMatcher) Match(actual interface{}) (bool, error) {
 }
 
 func (m *calledNMatcher) FailureMessage(actual interface{}) string {
-	return fmt.Sprintf("Expected\n%s\nto be called %d times", format.Object(actual, 1), m.n)
+	return fmt.Sprintf("Expected\n%s\nto be called %d times%s", format.Object(actual, 1), m.n, testutil.DescribeCalls(actual))
 }
 
 func (m *calledNMatcher) NegatedFailureMessage(actual interface{}) string {
-	return fmt.Sprintf("Expected\n%s\nnot to be called %d times", format.Object(actual, 1), m.n)
+	return fmt.Sprintf("Expected\n%s\nnot to be called %d times%s", format.Object(actual, 1), m.n, testutil.DescribeCalls(actual))
 }
@@ -36,11 +36,11 @@ func (m *calledWithMatcher) Match(actual interface{}) (bool, error) {
 }
 
 func (m *calledWithMatcher) FailureMessage(actual interface{}) string {
-	return format.Message(actual, "to contain at least one call with argument list matching", m.args)
+	return format.Message(actual, "to contain at least one call with argument list matching", m.args) + testutil.DescribeCalls(actual)
 }
 
 func (m *calledWithMatcher) NegatedFailureMessage(actual interface{}) string {
-	return format.Message(actual, "not to contain at least one call with argument list matching", m.args)
+	return format.Message(actual, "not to contain at least one call with argument list matching", m.args) + testutil.DescribeCalls(actual)
 }
 
 // BeCalledOnceWith constructs a matcher that asserts the mock function object was called exactly once
@@ -83,11 +83,11 @@ func (m *calledNWithMatcher) Match(actual interface{}) (bool, error) {
 }
 
 func (m *calledNWithMatcher) FailureMessage(actual interface{}) string {
-	return format.Message(actual, "to contain one call with argument list matching", m.args)
+	return format.Message(actual, "to contain one call with argument list matching", m.args) + testutil.DescribeCalls(actual)
 }
 
 func (m *calledNWithMatcher) NegatedFailureMessage(actual interface{}) string {
-	return format.Message(actual, "not to contain one call with argument list matching", m.args)
+	return format.Message(actual, "not to contain one call with argument list matching", m.args) + testutil.DescribeCalls(actual)
 }
 
 // getCallHistoryWith returns the set of call instances matching the given values. The values can
@@ -0,0 +1,69 @@
+package matchers
+
+import (
+	"fmt"
+
+	"github.com/derision-test/go-mockgen/v2/internal/testutil"
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/types"
+)
+
+type concurrencyMatcher struct {
+	name           string
+	n              int
+	atMost         bool
+	maxConcurrency int
+}
+
+var _ types.GomegaMatcher = &concurrencyMatcher{}
+
+// HaveAtMostConcurrentCalls constructs a matcher that asserts no more than n invocations
+// of the mock function object were in flight at once. The mock must be generated with
+// concurrency tracking enabled.
+func HaveAtMostConcurrentCalls(n int) types.GomegaMatcher {
+	return &concurrencyMatcher{
+		name:   "HaveAtMostConcurrentCalls",
+		n:      n,
+		atMost: true,
+	}
+}
+
+// HaveAtLeastConcurrentCalls constructs a matcher that asserts at least n invocations of
+// the mock function object were in flight at once. The mock must be generated with
+// concurrency tracking enabled.
+func HaveAtLeastConcurrentCalls(n int) types.GomegaMatcher {
+	return &concurrencyMatcher{
+		name: "HaveAtLeastConcurrentCalls",
+		n:    n,
+	}
+}
+
+func (m *concurrencyMatcher) Match(actual interface{}) (bool, error) {
+	maxConcurrency, ok := testutil.GetMaxConcurrency(actual)
+	if !ok {
+		return false, fmt.Errorf("%s expects a mock function description with concurrency tracking. Got:\n%s", m.name, format.Object(actual, 1))
+	}
+
+	m.maxConcurrency = maxConcurrency
+	if m.atMost {
+		return maxConcurrency <= m.n, nil
+	}
+
+	return maxConcurrency >= m.n, nil
+}
+
+func (m *concurrencyMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n%s\nto be called %s %d times concurrently, called %d times concurrently", format.Object(actual, 1), m.bound(), m.n, m.maxConcurrency)
+}
+
+func (m *concurrencyMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n%s\nnot to be called %s %d times concurrently, called %d times concurrently", format.Object(actual, 1), m.bound(), m.n, m.maxConcurrency)
+}
+
+func (m *concurrencyMatcher) bound() string {
+	if m.atMost {
+		return "at most"
+	}
+
+	return "at least"
+}
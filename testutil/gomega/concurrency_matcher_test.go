@@ -0,0 +1,47 @@
+package matchers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHaveAtMostConcurrentCallsMatch(t *testing.T) {
+	ok, err := HaveAtMostConcurrentCalls(2).Match(newMaxConcurrency(2))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestHaveAtMostConcurrentCallsMatchExceeded(t *testing.T) {
+	matcher := HaveAtMostConcurrentCalls(2)
+	ok, err := matcher.Match(newMaxConcurrency(3))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Contains(t, matcher.FailureMessage(newMaxConcurrency(3)), "to be called at most 2 times concurrently, called 3 times concurrently")
+}
+
+func TestHaveAtMostConcurrentCallsMatchError(t *testing.T) {
+	_, err := HaveAtMostConcurrentCalls(2).Match(nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "HaveAtMostConcurrentCalls expects a mock function")
+}
+
+func TestHaveAtLeastConcurrentCallsMatch(t *testing.T) {
+	ok, err := HaveAtLeastConcurrentCalls(2).Match(newMaxConcurrency(3))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestHaveAtLeastConcurrentCallsMatchSerial(t *testing.T) {
+	matcher := HaveAtLeastConcurrentCalls(2)
+	ok, err := matcher.Match(newMaxConcurrency(1))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Contains(t, matcher.FailureMessage(newMaxConcurrency(1)), "to be called at least 2 times concurrently, called 1 times concurrently")
+}
+
+func TestHaveAtLeastConcurrentCallsMatchError(t *testing.T) {
+	_, err := HaveAtLeastConcurrentCalls(2).Match(nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "HaveAtLeastConcurrentCalls expects a mock function")
+}
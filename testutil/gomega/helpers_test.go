@@ -1,7 +1,9 @@
 package matchers
 
 type mockFunc struct {
-	history []mockCall
+	history        []mockCall
+	pending        int
+	maxConcurrency int
 }
 
 type mockCall struct {
@@ -15,6 +17,43 @@ func newHistory(calls ...mockCall) *mockFunc {
 	}
 }
 
+func newPendingHooks(n int) *mockFunc {
+	return &mockFunc{
+		pending: n,
+	}
+}
+
+func newMaxConcurrency(n int) *mockFunc {
+	return &mockFunc{
+		maxConcurrency: n,
+	}
+}
+
 func (m mockFunc) History() []mockCall    { return m.history }
+func (m mockFunc) PendingHooks() int      { return m.pending }
+func (m mockFunc) MaxConcurrency() int    { return m.maxConcurrency }
 func (m mockCall) Args() []interface{}    { return m.args }
 func (m mockCall) Results() []interface{} { return m.results }
+
+type sequencedFunc struct {
+	history []sequencedCall
+}
+
+type sequencedCall struct {
+	mockCall
+	sequence uint64
+}
+
+func newSequencedHistory(sequences ...uint64) *sequencedFunc {
+	history := make([]sequencedCall, 0, len(sequences))
+	for _, sequence := range sequences {
+		history = append(history, sequencedCall{sequence: sequence})
+	}
+
+	return &sequencedFunc{
+		history: history,
+	}
+}
+
+func (m sequencedFunc) History() []sequencedCall { return m.history }
+func (m sequencedCall) Sequence() uint64         { return m.sequence }
@@ -0,0 +1,42 @@
+package matchers
+
+import (
+	"fmt"
+
+	"github.com/derision-test/go-mockgen/v2/internal/testutil"
+	"github.com/onsi/gomega/format"
+	"github.com/onsi/gomega/types"
+)
+
+type hooksConsumedMatcher struct {
+	name    string
+	pending int
+}
+
+var _ types.GomegaMatcher = &hooksConsumedMatcher{}
+
+// HaveConsumedAllHooks constructs a matcher that asserts the mock function object has
+// invoked every hook and return value pushed onto its queue.
+func HaveConsumedAllHooks() types.GomegaMatcher {
+	return &hooksConsumedMatcher{
+		name: "HaveConsumedAllHooks",
+	}
+}
+
+func (m *hooksConsumedMatcher) Match(actual interface{}) (bool, error) {
+	pending, ok := testutil.GetPendingHooks(actual)
+	if !ok {
+		return false, fmt.Errorf("%s expects a mock function description. Got:\n%s", m.name, format.Object(actual, 1))
+	}
+
+	m.pending = pending
+	return pending == 0, nil
+}
+
+func (m *hooksConsumedMatcher) FailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n%s\nto have consumed all hooks, %d hooks are pending", format.Object(actual, 1), m.pending)
+}
+
+func (m *hooksConsumedMatcher) NegatedFailureMessage(actual interface{}) string {
+	return fmt.Sprintf("Expected\n%s\nnot to have consumed all hooks", format.Object(actual, 1))
+}
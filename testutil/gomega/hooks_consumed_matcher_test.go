@@ -0,0 +1,27 @@
+package matchers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHaveConsumedAllHooksMatch(t *testing.T) {
+	ok, err := HaveConsumedAllHooks().Match(newPendingHooks(0))
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestHaveConsumedAllHooksMatchPending(t *testing.T) {
+	matcher := HaveConsumedAllHooks()
+	ok, err := matcher.Match(newPendingHooks(2))
+	assert.Nil(t, err)
+	assert.False(t, ok)
+	assert.Contains(t, matcher.FailureMessage(newPendingHooks(2)), "2 hooks are pending")
+}
+
+func TestHaveConsumedAllHooksMatchError(t *testing.T) {
+	_, err := HaveConsumedAllHooks().Match(nil)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "HaveConsumedAllHooks expects a mock function")
+}
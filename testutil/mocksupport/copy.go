@@ -0,0 +1,175 @@
+package mocksupport
+
+import (
+	"reflect"
+	"sync"
+)
+
+// DeepCopy returns a copy of the given value that shares no slices, maps, or pointed-to
+// values with the original. Values implementing a Clone method that takes no arguments and
+// returns a value of their own type are copied by calling that method. Exported fields of
+// structs are copied recursively; unexported fields, channels, and functions are copied
+// shallowly.
+//
+// Only the data owned by the value is copied. Values held in interfaces (such as a
+// context.Context or an io.Reader) are not owned by the value holding them and are kept
+// as-is, as are values of types that must not be copied: structs containing a lock (such
+// as a sync.Mutex), a noCopy marker (such as a sync.WaitGroup), or a channel, along with
+// pointers to, slices of, and maps of such values. Kept values retain their identity.
+func DeepCopy[T any](v T) T {
+	if reflect.TypeFor[T]().Kind() == reflect.Interface {
+		return v
+	}
+
+	value := reflect.ValueOf(v)
+	if !value.IsValid() {
+		return v
+	}
+
+	copied, ok := deepCopyValue(value, map[copiedPointer]reflect.Value{}).Interface().(T)
+	if !ok {
+		return v
+	}
+
+	return copied
+}
+
+// copiedPointer identifies a copied pointer. Pointers to a struct and to its first field
+// share an address, so the address alone does not identify the copy.
+type copiedPointer struct {
+	address uintptr
+	typ     reflect.Type
+}
+
+func deepCopyValue(value reflect.Value, seen map[copiedPointer]reflect.Value) reflect.Value {
+	if cloned, ok := cloneValue(value); ok {
+		return cloned
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() || !isCopyable(value.Type().Elem()) {
+			return value
+		}
+		key := copiedPointer{address: value.Pointer(), typ: value.Type()}
+		if copied, ok := seen[key]; ok {
+			return copied
+		}
+
+		copied := reflect.New(value.Type().Elem())
+		seen[key] = copied
+		copied.Elem().Set(deepCopyValue(value.Elem(), seen))
+		return copied
+
+	case reflect.Slice:
+		if value.IsNil() || !isCopyable(value.Type().Elem()) {
+			return value
+		}
+
+		copied := reflect.MakeSlice(value.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			copied.Index(i).Set(deepCopyValue(value.Index(i), seen))
+		}
+		return copied
+
+	case reflect.Array:
+		if !isCopyable(value.Type()) {
+			return value
+		}
+
+		copied := reflect.New(value.Type()).Elem()
+		for i := 0; i < value.Len(); i++ {
+			copied.Index(i).Set(deepCopyValue(value.Index(i), seen))
+		}
+		return copied
+
+	case reflect.Map:
+		if value.IsNil() || !isCopyable(value.Type().Key()) || !isCopyable(value.Type().Elem()) {
+			return value
+		}
+
+		copied := reflect.MakeMapWithSize(value.Type(), value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			copied.SetMapIndex(deepCopyValue(iter.Key(), seen), deepCopyValue(iter.Value(), seen))
+		}
+		return copied
+
+	case reflect.Struct:
+		if !isCopyable(value.Type()) {
+			return value
+		}
+
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		for i := 0; i < value.NumField(); i++ {
+			if field := copied.Field(i); field.CanSet() {
+				field.Set(deepCopyValue(value.Field(i), seen))
+			}
+		}
+		return copied
+	}
+
+	return value
+}
+
+var (
+	lockerType    = reflect.TypeFor[sync.Locker]()
+	copyableTypes sync.Map // map[reflect.Type]bool
+)
+
+// isCopyable returns false if values of the given type must not be copied: structs
+// containing a lock, a noCopy marker, or a channel, and arrays of such values.
+func isCopyable(typ reflect.Type) bool {
+	if copyable, ok := copyableTypes.Load(typ); ok {
+		return copyable.(bool)
+	}
+
+	copyable := true
+	switch typ.Kind() {
+	case reflect.Array:
+		copyable = isCopyable(typ.Elem())
+
+	case reflect.Struct:
+		if typ.Name() == "noCopy" || reflect.PointerTo(typ).Implements(lockerType) {
+			copyable = false
+			break
+		}
+
+		for i := 0; i < typ.NumField(); i++ {
+			switch fieldType := typ.Field(i).Type; fieldType.Kind() {
+			case reflect.Chan:
+				copyable = false
+			case reflect.Array, reflect.Struct:
+				copyable = isCopyable(fieldType)
+			}
+			if !copyable {
+				break
+			}
+		}
+	}
+
+	copyableTypes.Store(typ, copyable)
+	return copyable
+}
+
+// cloneValue invokes the Clone method of the given value if it takes no arguments and
+// returns a value of the same type.
+func cloneValue(value reflect.Value) (reflect.Value, bool) {
+	if value.Kind() == reflect.Ptr && value.IsNil() {
+		return reflect.Value{}, false
+	}
+	if value.Kind() == reflect.Interface {
+		return reflect.Value{}, false
+	}
+
+	method := value.MethodByName("Clone")
+	if !method.IsValid() {
+		return reflect.Value{}, false
+	}
+	if method.Type().NumIn() != 0 || method.Type().NumOut() != 1 || method.Type().Out(0) != value.Type() {
+		return reflect.Value{}, false
+	}
+
+	return method.Call(nil)[0], true
+}
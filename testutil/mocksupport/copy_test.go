@@ -0,0 +1,160 @@
+package mocksupport
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type copyTestStruct struct {
+	Name     string
+	Values   []int
+	Children map[string]*copyTestStruct
+	hidden   *int
+}
+
+type copyTestKey struct{}
+
+type cloneable struct {
+	values []int
+	clones *int
+}
+
+func (c *cloneable) Clone() *cloneable {
+	*c.clones++
+	return &cloneable{values: append([]int(nil), c.values...), clones: c.clones}
+}
+
+func TestDeepCopySlice(t *testing.T) {
+	original := []int{1, 2, 3}
+	copied := DeepCopy(original)
+	original[0] = 4
+
+	assert.Equal(t, []int{1, 2, 3}, copied)
+}
+
+func TestDeepCopyMap(t *testing.T) {
+	original := map[string][]int{"a": {1}}
+	copied := DeepCopy(original)
+	original["a"][0] = 2
+	original["b"] = nil
+
+	assert.Equal(t, map[string][]int{"a": {1}}, copied)
+}
+
+func TestDeepCopyStruct(t *testing.T) {
+	hidden := 1
+	original := &copyTestStruct{
+		Name:     "root",
+		Values:   []int{1, 2},
+		Children: map[string]*copyTestStruct{"child": {Name: "child"}},
+		hidden:   &hidden,
+	}
+	copied := DeepCopy(original)
+	original.Values[0] = 3
+	original.Children["child"].Name = "changed"
+
+	assert.Equal(t, []int{1, 2}, copied.Values)
+	assert.Equal(t, "child", copied.Children["child"].Name)
+	assert.Same(t, original.hidden, copied.hidden)
+}
+
+func TestDeepCopyCycle(t *testing.T) {
+	type node struct{ Next *node }
+	original := &node{}
+	original.Next = original
+
+	copied := DeepCopy(original)
+	assert.NotSame(t, original, copied)
+	assert.Same(t, copied, copied.Next)
+}
+
+func TestDeepCopyAliasedPointersOfDifferentTypes(t *testing.T) {
+	type inner struct{ Values []int }
+	type outer struct {
+		Inner inner
+		Name  string
+	}
+	type aliases struct {
+		Outer *outer
+		Inner *inner
+	}
+	value := &outer{Inner: inner{Values: []int{1}}, Name: "outer"}
+	original := aliases{Outer: value, Inner: &value.Inner}
+
+	copied := DeepCopy(original)
+	value.Inner.Values[0] = 2
+
+	assert.Equal(t, "outer", copied.Outer.Name)
+	assert.Equal(t, []int{1}, copied.Outer.Inner.Values)
+	assert.Equal(t, []int{1}, copied.Inner.Values)
+}
+
+func TestDeepCopyInterface(t *testing.T) {
+	// Values held in interfaces are not owned by the argument and are kept as-is
+	var original interface{} = []string{"a"}
+	copied := DeepCopy(original)
+	original.([]string)[0] = "b"
+
+	assert.Equal(t, []string{"b"}, copied)
+}
+
+func TestDeepCopyInterfaceElements(t *testing.T) {
+	ctx := context.WithValue(context.Background(), copyTestKey{}, []int{1})
+	original := []interface{}{ctx, []int{1}}
+	copied := DeepCopy(original)
+	original[1] = nil
+
+	assert.Len(t, copied, 2)
+	assert.True(t, ctx == copied[0])
+	assert.Equal(t, []int{1}, copied[1])
+}
+
+func TestDeepCopyNonCopyable(t *testing.T) {
+	type locked struct {
+		sync.Mutex
+		Values []int
+	}
+	type waiter struct {
+		wg     sync.WaitGroup
+		Values []int
+	}
+	type signaled struct {
+		Done   chan struct{}
+		Values []int
+	}
+
+	lockedValue := &locked{Values: []int{1}}
+	lockedValue.Lock()
+	defer lockedValue.Unlock()
+	assert.Same(t, lockedValue, DeepCopy(lockedValue))
+
+	waiterValue := &waiter{Values: []int{1}}
+	assert.Same(t, waiterValue, DeepCopy(waiterValue))
+
+	signaledValues := []*signaled{{Done: make(chan struct{})}}
+	copiedSignaledValues := DeepCopy(signaledValues)
+	assert.Same(t, signaledValues[0], copiedSignaledValues[0])
+
+	counters := map[string]*atomic.Int64{"a": {}}
+	assert.Same(t, counters["a"], DeepCopy(counters)["a"])
+}
+
+func TestDeepCopyClone(t *testing.T) {
+	clones := 0
+	original := &cloneable{values: []int{1}, clones: &clones}
+	copied := DeepCopy(original)
+	original.values[0] = 2
+
+	assert.Equal(t, 1, clones)
+	assert.Equal(t, []int{1}, copied.values)
+}
+
+func TestDeepCopyNil(t *testing.T) {
+	assert.Nil(t, DeepCopy[interface{}](nil))
+	assert.Nil(t, DeepCopy[[]int](nil))
+	assert.Nil(t, DeepCopy[*cloneable](nil))
+}
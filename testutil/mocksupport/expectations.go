@@ -0,0 +1,90 @@
+package mocksupport
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// TestingT is the subset of testing.TB used by generated mocks to report failures.
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+type tHelper interface {
+	Helper()
+}
+
+// ExpectationFailure returns a description of the given expectation if the number of
+// matching invocations differs from the expected number of invocations. An empty
+// string is returned if the expectation was met.
+func ExpectationFailure(name string, args []interface{}, times, calls int) string {
+	if calls < times {
+		return fmt.Sprintf("unmet expectation: expected %s to be called %d times, called %d times", FormatCall(name, args), times, calls)
+	}
+	if calls > times {
+		return fmt.Sprintf("over-satisfied expectation: expected %s to be called %d times, called %d times", FormatCall(name, args), times, calls)
+	}
+
+	return ""
+}
+
+// UnexpectedCallFailure returns a description of an invocation that did not match any
+// expectation.
+func UnexpectedCallFailure(name string, args []interface{}) string {
+	return fmt.Sprintf("unexpected call: %s", FormatCall(name, args))
+}
+
+// PendingHooksFailure returns a description of the named method with the given
+// number of hooks that were never invoked.
+func PendingHooksFailure(name string, n int) string {
+	return fmt.Sprintf("%s has %d unconsumed hooks", name, n)
+}
+
+// ReportFailures reports the given failures as a single error with the given summary
+// message via the given TestingT. The return value is true if there are no failures.
+func ReportFailures(t TestingT, message string, failures []string) bool {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	if len(failures) == 0 {
+		return true
+	}
+
+	t.Errorf("%s:\n  - %s", message, strings.Join(failures, "\n  - "))
+	return false
+}
+
+// FormatCall returns a human-readable representation of an invocation of the named
+// method with the given argument values.
+func FormatCall(name string, args []interface{}) string {
+	values := make([]string, 0, len(args))
+	for _, arg := range args {
+		values = append(values, formatValue(arg))
+	}
+
+	return fmt.Sprintf("%s(%s)", name, strings.Join(values, ", "))
+}
+
+func formatValue(v interface{}) string {
+	if v == nil {
+		return "nil"
+	}
+
+	if value := reflect.ValueOf(v); value.IsValid() && value.Kind() == reflect.Func {
+		return fmt.Sprintf("<%T>", v)
+	}
+
+	return fmt.Sprintf("%#v", v)
+}
+
+// ReportUnexpectedCall reports an invocation of the named method with the given
+// argument values that was not stubbed by the test.
+func ReportUnexpectedCall(t TestingT, name string, args []interface{}) {
+	if h, ok := t.(tHelper); ok {
+		h.Helper()
+	}
+
+	t.Errorf("unexpected invocation of %s", FormatCall(name, args))
+}
@@ -0,0 +1,53 @@
+package mocksupport
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpectationFailure(t *testing.T) {
+	assert.Equal(t, "", ExpectationFailure("M.Do", []interface{}{"foo"}, 2, 2))
+	assert.Equal(t, `unmet expectation: expected M.Do("foo") to be called 2 times, called 1 times`, ExpectationFailure("M.Do", []interface{}{"foo"}, 2, 1))
+	assert.Equal(t, `over-satisfied expectation: expected M.Do("foo") to be called 2 times, called 3 times`, ExpectationFailure("M.Do", []interface{}{"foo"}, 2, 3))
+}
+
+func TestUnexpectedCallFailure(t *testing.T) {
+	assert.Equal(t, `unexpected call: M.Do("foo", 3)`, UnexpectedCallFailure("M.Do", []interface{}{"foo", 3}))
+}
+
+func TestReportFailures(t *testing.T) {
+	testingT := &mockTestingT{}
+	assert.True(t, ReportFailures(testingT, "M expectations were not met", nil))
+	assert.Empty(t, testingT.errors)
+
+	assert.False(t, ReportFailures(testingT, "M expectations were not met", []string{"a", "b"}))
+	assert.Equal(t, []string{"M expectations were not met:\n  - a\n  - b"}, testingT.errors)
+}
+
+func TestPendingHooksFailure(t *testing.T) {
+	assert.Equal(t, "M.Do has 2 unconsumed hooks", PendingHooksFailure("M.Do", 2))
+}
+
+func TestFormatCall(t *testing.T) {
+	assert.Equal(t, `M.Do(<func(string) bool>, nil, []int{1, 2})`, FormatCall("M.Do", []interface{}{
+		func(v string) bool { return true },
+		nil,
+		[]int{1, 2},
+	}))
+}
+
+type mockTestingT struct {
+	errors []string
+}
+
+func (t *mockTestingT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestReportUnexpectedCall(t *testing.T) {
+	testingT := &mockTestingT{}
+	ReportUnexpectedCall(testingT, "M.Do", []interface{}{"foo"})
+	assert.Equal(t, []string{`unexpected invocation of M.Do("foo")`}, testingT.errors)
+}
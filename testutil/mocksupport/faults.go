@@ -0,0 +1,94 @@
+package mocksupport
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// Fault describes the faults injected into a single invocation of a mock function.
+type Fault struct {
+	// Latency is the duration by which the invocation was delayed.
+	Latency time.Duration
+
+	// Err is the error returned by the invocation in place of invoking a hook. A nil
+	// value indicates that the invocation was handled by a hook as usual.
+	Err error
+}
+
+// Faults holds the fault configuration of a single mock function. The zero value
+// injects no faults and draws from a source seeded with zero.
+type Faults struct {
+	mutex     sync.Mutex
+	source    *rand.Rand
+	errorRate float64
+	err       error
+	latency   time.Duration
+}
+
+// Seed replaces the source from which injected errors are drawn.
+func (f *Faults) Seed(seed int64) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.source = rand.New(rand.NewSource(seed))
+}
+
+// SetError configures the given error to be injected into the given fraction of
+// invocations. A rate of zero or a nil error disables error injection.
+func (f *Faults) SetError(rate float64, err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.errorRate = rate
+	f.err = err
+}
+
+// SetLatency configures every invocation to be delayed by the given duration.
+func (f *Faults) SetLatency(d time.Duration) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.latency = d
+}
+
+// Reset disables all fault injection and restores the default source.
+func (f *Faults) Reset() {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	f.source = nil
+	f.errorRate = 0
+	f.err = nil
+	f.latency = 0
+}
+
+// Next determines the faults to inject into an invocation and blocks for the
+// configured latency before returning them.
+func (f *Faults) Next() Fault {
+	fault := f.next()
+	if fault.Latency > 0 {
+		time.Sleep(fault.Latency)
+	}
+
+	return fault
+}
+
+func (f *Faults) next() Fault {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	fault := Fault{Latency: f.latency}
+	if f.err == nil || f.errorRate <= 0 {
+		return fault
+	}
+
+	if f.source == nil {
+		f.source = rand.New(rand.NewSource(0))
+	}
+	if f.source.Float64() < f.errorRate {
+		fault.Err = f.err
+	}
+
+	return fault
+}
@@ -0,0 +1,59 @@
+package mocksupport
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFaultsZeroValue(t *testing.T) {
+	var faults Faults
+	assert.Equal(t, Fault{}, faults.Next())
+}
+
+func TestFaultsError(t *testing.T) {
+	errInjected := errors.New("injected")
+
+	draw := func(seed int64) []bool {
+		var faults Faults
+		faults.Seed(seed)
+		faults.SetError(0.3, errInjected)
+
+		injected := make([]bool, 1000)
+		for i := range injected {
+			fault := faults.Next()
+			injected[i] = fault.Err != nil
+			if fault.Err != nil {
+				assert.Equal(t, errInjected, fault.Err)
+			}
+		}
+
+		return injected
+	}
+
+	injected := draw(1)
+	assert.Equal(t, injected, draw(1))
+	assert.NotEqual(t, injected, draw(2))
+
+	count := 0
+	for _, ok := range injected {
+		if ok {
+			count++
+		}
+	}
+	assert.InDelta(t, 300, count, 60)
+}
+
+func TestFaultsLatency(t *testing.T) {
+	var faults Faults
+	faults.SetLatency(20 * time.Millisecond)
+
+	start := time.Now()
+	assert.Equal(t, Fault{Latency: 20 * time.Millisecond}, faults.Next())
+	assert.GreaterOrEqual(t, time.Since(start), 20*time.Millisecond)
+
+	faults.Reset()
+	assert.Equal(t, Fault{}, faults.Next())
+}
@@ -0,0 +1,30 @@
+package mocksupport
+
+import (
+	"bytes"
+	"reflect"
+)
+
+// ValuesEqual determines if the given argument value is equal to the expected value.
+// Values are compared via reflect.DeepEqual, except that byte slices are compared by
+// content.
+func ValuesEqual(expectedValue, arg interface{}) bool {
+	if expectedValue == nil || arg == nil {
+		return expectedValue == arg
+	}
+
+	exp, ok := expectedValue.([]byte)
+	if !ok {
+		return reflect.DeepEqual(expectedValue, arg)
+	}
+
+	act, ok := arg.([]byte)
+	if !ok {
+		return false
+	}
+	if exp == nil || act == nil {
+		return exp == nil && act == nil
+	}
+
+	return bytes.Equal(exp, act)
+}
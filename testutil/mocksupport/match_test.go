@@ -0,0 +1,15 @@
+package mocksupport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValuesEqual(t *testing.T) {
+	assert.True(t, ValuesEqual(nil, nil))
+	assert.True(t, ValuesEqual([]string{"foo"}, []string{"foo"}))
+	assert.True(t, ValuesEqual([]byte("foo"), []byte("foo")))
+	assert.False(t, ValuesEqual([]byte("foo"), "foo"))
+	assert.False(t, ValuesEqual(nil, 123))
+}
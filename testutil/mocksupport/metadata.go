@@ -0,0 +1,61 @@
+package mocksupport
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strconv"
+	"time"
+)
+
+// CallMetadata describes when, where, and from which goroutine a mock function was invoked.
+type CallMetadata struct {
+	// Timestamp is the time at which the invocation began.
+	Timestamp time.Time
+	// GoroutineID is the identifier of the goroutine that made the invocation.
+	GoroutineID uint64
+	// Caller is the file:line location of the code that invoked the mock.
+	Caller string
+}
+
+// CaptureCallMetadata returns the metadata of the current invocation. This function must be
+// called directly from the body of a generated mock method so that the caller of that method
+// is recorded.
+func CaptureCallMetadata() CallMetadata {
+	caller := "unknown"
+	if _, file, line, ok := runtime.Caller(2); ok {
+		caller = fmt.Sprintf("%s:%d", file, line)
+	}
+
+	return CallMetadata{
+		Timestamp:   time.Now(),
+		GoroutineID: currentGoroutineID(),
+		Caller:      caller,
+	}
+}
+
+// String returns a human-readable description of the metadata.
+func (m CallMetadata) String() string {
+	return fmt.Sprintf("at %s on goroutine %d (%s)", m.Caller, m.GoroutineID, m.Timestamp.Format(time.RFC3339Nano))
+}
+
+var goroutinePrefix = []byte("goroutine ")
+
+// currentGoroutineID parses the identifier of the current goroutine from the header of its
+// stack trace. Zero is returned if the header cannot be parsed.
+func currentGoroutineID() uint64 {
+	buf := make([]byte, 64)
+	buf = buf[:runtime.Stack(buf, false)]
+	buf = bytes.TrimPrefix(buf, goroutinePrefix)
+
+	if i := bytes.IndexByte(buf, ' '); i >= 0 {
+		buf = buf[:i]
+	}
+
+	id, err := strconv.ParseUint(string(buf), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return id
+}
@@ -0,0 +1,39 @@
+package mocksupport
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCaptureCallMetadata(t *testing.T) {
+	before := time.Now()
+	metadata := captureFromMockMethod()
+
+	assert.False(t, metadata.Timestamp.Before(before))
+	assert.NotZero(t, metadata.GoroutineID)
+	assert.True(t, strings.HasSuffix(metadata.Caller, "metadata_test.go:13"), metadata.Caller)
+}
+
+func captureFromMockMethod() CallMetadata {
+	return CaptureCallMetadata()
+}
+
+func TestCurrentGoroutineIDDistinct(t *testing.T) {
+	ch := make(chan uint64)
+	go func() { ch <- currentGoroutineID() }()
+
+	assert.NotEqual(t, currentGoroutineID(), <-ch)
+}
+
+func TestCallMetadataString(t *testing.T) {
+	metadata := CallMetadata{
+		Timestamp:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		GoroutineID: 12,
+		Caller:      "foo.go:34",
+	}
+
+	assert.Equal(t, "at foo.go:34 on goroutine 12 (2024-01-02T03:04:05Z)", metadata.String())
+}
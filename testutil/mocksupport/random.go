@@ -0,0 +1,129 @@
+package mocksupport
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"reflect"
+	"sync"
+)
+
+const (
+	// maxRandomLength is the maximum length of generated strings, slices, and maps.
+	maxRandomLength = 4
+
+	// maxRandomDepth is the maximum number of nested pointers, slices, and maps that
+	// are populated, which bounds the size of values of recursive types.
+	maxRandomDepth = 4
+)
+
+const randomLetters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// Random generates arbitrary values for the results of a mock's methods. Each method
+// draws from its own source derived from the seed and the method name, so the values
+// returned by one method do not depend on how invocations of other methods interleave.
+type Random struct {
+	seed    int64
+	mutex   sync.Mutex
+	sources map[string]*rand.Rand
+}
+
+// NewRandom creates a value generator that is deterministic for the given seed.
+func NewRandom(seed int64) *Random {
+	return &Random{seed: seed, sources: map[string]*rand.Rand{}}
+}
+
+// Fill populates each of the values pointed to by ptrs with arbitrary values drawn
+// from the source of the named method. Booleans, numbers, strings, arrays, slices,
+// maps, exported struct fields, and pointers are populated. Interfaces, functions,
+// and channels are left nil.
+func (r *Random) Fill(method string, ptrs ...interface{}) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	source, ok := r.sources[method]
+	if !ok {
+		hash := fnv.New64a()
+		_, _ = hash.Write([]byte(method))
+		source = rand.New(rand.NewSource(r.seed ^ int64(hash.Sum64())))
+		r.sources[method] = source
+	}
+
+	for _, ptr := range ptrs {
+		fillRandom(source, reflect.ValueOf(ptr).Elem(), 0)
+	}
+}
+
+func fillRandom(source *rand.Rand, value reflect.Value, depth int) {
+	switch value.Kind() {
+	case reflect.Bool:
+		value.SetBool(source.Intn(2) == 1)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value.SetInt(int64(source.Uint64()))
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		value.SetUint(source.Uint64())
+
+	case reflect.Float32, reflect.Float64:
+		value.SetFloat(source.NormFloat64() * 1000)
+
+	case reflect.Complex64, reflect.Complex128:
+		value.SetComplex(complex(source.NormFloat64()*1000, source.NormFloat64()*1000))
+
+	case reflect.String:
+		letters := make([]byte, source.Intn(maxRandomLength*2+1))
+		for i := range letters {
+			letters[i] = randomLetters[source.Intn(len(randomLetters))]
+		}
+		value.SetString(string(letters))
+
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			fillRandom(source, value.Index(i), depth)
+		}
+
+	case reflect.Slice:
+		if depth >= maxRandomDepth {
+			return
+		}
+
+		n := source.Intn(maxRandomLength + 1)
+		slice := reflect.MakeSlice(value.Type(), n, n)
+		for i := 0; i < n; i++ {
+			fillRandom(source, slice.Index(i), depth+1)
+		}
+		value.Set(slice)
+
+	case reflect.Map:
+		if depth >= maxRandomDepth {
+			return
+		}
+
+		n := source.Intn(maxRandomLength + 1)
+		m := reflect.MakeMapWithSize(value.Type(), n)
+		for i := 0; i < n; i++ {
+			key := reflect.New(value.Type().Key()).Elem()
+			elem := reflect.New(value.Type().Elem()).Elem()
+			fillRandom(source, key, depth+1)
+			fillRandom(source, elem, depth+1)
+			m.SetMapIndex(key, elem)
+		}
+		value.Set(m)
+
+	case reflect.Struct:
+		for i := 0; i < value.NumField(); i++ {
+			if value.Type().Field(i).IsExported() {
+				fillRandom(source, value.Field(i), depth)
+			}
+		}
+
+	case reflect.Ptr:
+		if depth >= maxRandomDepth {
+			return
+		}
+
+		ptr := reflect.New(value.Type().Elem())
+		fillRandom(source, ptr.Elem(), depth+1)
+		value.Set(ptr)
+	}
+}
@@ -0,0 +1,60 @@
+package mocksupport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type randomTestStruct struct {
+	Name     string
+	Values   []int
+	Labels   map[string]bool
+	Next     *randomTestStruct
+	Err      error
+	Callback func()
+	hidden   int
+}
+
+func TestRandomFill(t *testing.T) {
+	random := NewRandom(42)
+
+	var s randomTestStruct
+	var p *randomTestStruct
+	random.Fill("Get", &s, &p)
+
+	assert.NotNil(t, p)
+	assert.Nil(t, s.Err)
+	assert.Nil(t, s.Callback)
+	assert.Zero(t, s.hidden)
+	assert.LessOrEqual(t, len(s.Values), maxRandomLength)
+	assert.LessOrEqual(t, len(s.Labels), maxRandomLength)
+
+	// Recursive types are populated to a bounded depth
+	depth := 0
+	for next := p; next != nil; next = next.Next {
+		depth++
+	}
+	assert.LessOrEqual(t, depth, maxRandomDepth)
+}
+
+func TestRandomDeterministic(t *testing.T) {
+	generate := func(seed int64, interleave bool) []randomTestStruct {
+		random := NewRandom(seed)
+
+		values := make([]randomTestStruct, 5)
+		for i := range values {
+			if interleave {
+				var ignored string
+				random.Fill("Other", &ignored)
+			}
+			random.Fill("Get", &values[i])
+		}
+
+		return values
+	}
+
+	assert.Equal(t, generate(1, false), generate(1, false))
+	assert.Equal(t, generate(1, false), generate(1, true))
+	assert.NotEqual(t, generate(1, false), generate(2, false))
+}
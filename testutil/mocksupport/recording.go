@@ -0,0 +1,249 @@
+package mocksupport
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// recordedCall is the serialized form of a single invocation in a fixture file.
+type recordedCall struct {
+	Method  string            `json:"method"`
+	Args    []json.RawMessage `json:"args"`
+	Results []json.RawMessage `json:"results"`
+}
+
+// Recorder collects the arguments and results of the invocations of a recording mock
+// so that they can be written to a JSON fixture file and later replayed by a mock
+// constructed from that recording.
+type Recorder struct {
+	path  string
+	mutex sync.Mutex
+	calls []recordedCall
+	err   error
+}
+
+// NewRecorder creates a recorder that writes its fixture to the given path on Save.
+func NewRecorder(path string) *Recorder {
+	return &Recorder{path: path, calls: []recordedCall{}}
+}
+
+// Record adds an invocation of the named method to the recording. Both args and
+// results must hold pointers to the values passed to and returned by the method so
+// that their static types are known. Arguments that cannot be encoded as JSON, such
+// as functions and channels, are recorded as null. Results that cannot be encoded
+// cause Save to fail.
+func (r *Recorder) Record(method string, args []interface{}, results []interface{}) {
+	call := recordedCall{
+		Method:  method,
+		Args:    make([]json.RawMessage, 0, len(args)),
+		Results: make([]json.RawMessage, 0, len(results)),
+	}
+
+	for _, arg := range args {
+		raw, err := encodeValue(arg)
+		if err != nil {
+			raw = json.RawMessage("null")
+		}
+		call.Args = append(call.Args, raw)
+	}
+
+	var resultErr error
+	for i, result := range results {
+		raw, err := encodeValue(result)
+		if err != nil && resultErr == nil {
+			resultErr = fmt.Errorf("failed to encode result %d of %s: %s", i, method, err)
+		}
+		call.Results = append(call.Results, raw)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if resultErr != nil {
+		if r.err == nil {
+			r.err = resultErr
+		}
+		return
+	}
+
+	r.calls = append(r.calls, call)
+}
+
+// Save writes all recorded invocations to the recorder's fixture file. An error is
+// returned if the file cannot be written or if any recorded result could not be
+// encoded as JSON.
+func (r *Recorder) Save() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.err != nil {
+		return r.err
+	}
+
+	content, err := json.MarshalIndent(r.calls, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(r.path, append(content, '\n'), 0644)
+}
+
+// Recording is a set of invocations loaded from a JSON fixture file written by a
+// Recorder.
+type Recording struct {
+	path  string
+	mutex sync.Mutex
+	calls []recordedCall
+	used  []bool
+}
+
+// LoadRecording reads the JSON fixture file at the given path.
+func LoadRecording(path string) (*Recording, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var calls []recordedCall
+	if err := json.Unmarshal(content, &calls); err != nil {
+		return nil, fmt.Errorf("failed to parse recording %s: %s", path, err)
+	}
+
+	for _, call := range calls {
+		for i, arg := range call.Args {
+			buffer := &bytes.Buffer{}
+			if err := json.Compact(buffer, arg); err != nil {
+				return nil, fmt.Errorf("failed to parse recording %s: %s", path, err)
+			}
+			call.Args[i] = buffer.Bytes()
+		}
+	}
+
+	return &Recording{path: path, calls: calls, used: make([]bool, len(calls))}, nil
+}
+
+// Replay decodes the results of a recorded invocation of the named method with the
+// given arguments into results. Both args and results must hold pointers, as with
+// Recorder.Record. Invocations recorded with the same arguments are replayed in the
+// order they were recorded, after which the last of them is replayed indefinitely.
+// Replay returns false and leaves results untouched if no invocation with matching
+// arguments was recorded, and panics if the recorded results cannot be decoded.
+//
+// Arguments of an interface type (such as context.Context) and arguments that cannot
+// be encoded as JSON match any recorded value, as their encoding rarely identifies
+// the invocation: a context created by the test that replays the recording differs
+// from the one passed while recording.
+//
+// Results are decoded into their static types, so values held in an interface type
+// other than error are decoded as generic JSON values (float64, string, bool,
+// []interface{}, or map[string]interface{}), and errors are decoded via errors.New
+// with the recorded message, so errors.Is and errors.As do not match the original.
+func (r *Recording) Replay(method string, args []interface{}, results ...interface{}) bool {
+	call, ok := r.match(method, args)
+	if !ok {
+		return false
+	}
+
+	if len(call.Results) != len(results) {
+		panic(fmt.Sprintf("recorded invocation of %s in %s has %d results, expected %d", method, r.path, len(call.Results), len(results)))
+	}
+
+	for i, result := range results {
+		if err := decodeValue(call.Results[i], result); err != nil {
+			panic(fmt.Sprintf("failed to decode result %d of %s recorded in %s: %s", i, method, r.path, err))
+		}
+	}
+
+	return true
+}
+
+func (r *Recording) match(method string, args []interface{}) (recordedCall, bool) {
+	// A nil entry matches any recorded argument
+	encodedArgs := make([]json.RawMessage, 0, len(args))
+	for _, arg := range args {
+		var raw json.RawMessage
+		if reflect.TypeOf(arg).Elem().Kind() != reflect.Interface {
+			raw, _ = encodeValue(arg)
+		}
+		encodedArgs = append(encodedArgs, raw)
+	}
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	last := -1
+	for i, call := range r.calls {
+		if call.Method != method || !argsEqual(call.Args, encodedArgs) {
+			continue
+		}
+
+		if !r.used[i] {
+			r.used[i] = true
+			return call, true
+		}
+		last = i
+	}
+
+	if last < 0 {
+		return recordedCall{}, false
+	}
+
+	return r.calls[last], true
+}
+
+// argsEqual returns true if the recorded arguments a match the encoded arguments b.
+// Nil entries of b match any recorded argument.
+func argsEqual(a, b []json.RawMessage) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if b[i] != nil && !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// encodeValue encodes the value pointed to by ptr. Values of the error interface type
+// are encoded as their message, as most error implementations have no exported fields.
+func encodeValue(ptr interface{}) (json.RawMessage, error) {
+	value := reflect.ValueOf(ptr).Elem()
+	if value.Type() == errorType {
+		if value.IsNil() {
+			return json.RawMessage("null"), nil
+		}
+
+		return json.Marshal(value.Interface().(error).Error())
+	}
+
+	return json.Marshal(value.Interface())
+}
+
+// decodeValue decodes the given value into the value pointed to by ptr. Values of the
+// error interface type are decoded as an error with the encoded message.
+func decodeValue(raw json.RawMessage, ptr interface{}) error {
+	value := reflect.ValueOf(ptr).Elem()
+	if value.Type() == errorType {
+		var message *string
+		if err := json.Unmarshal(raw, &message); err != nil {
+			return err
+		}
+		if message != nil {
+			value.Set(reflect.ValueOf(errors.New(*message)))
+		}
+
+		return nil
+	}
+
+	return json.Unmarshal(raw, ptr)
+}
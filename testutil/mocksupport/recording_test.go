@@ -0,0 +1,131 @@
+package mocksupport
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.json")
+
+	recorder := NewRecorder(path)
+	record := func(key string, value int, err error) {
+		recorder.Record("Get", []interface{}{&key}, []interface{}{&value, &err})
+	}
+	record("a", 1, nil)
+	record("a", 2, nil)
+	record("b", 0, errors.New("not found"))
+	require.NoError(t, recorder.Save())
+
+	recording, err := LoadRecording(path)
+	require.NoError(t, err)
+
+	replay := func(key string) (value int, err error) {
+		assert.True(t, recording.Replay("Get", []interface{}{&key}, &value, &err))
+		return value, err
+	}
+
+	for _, expected := range []int{1, 2, 2} {
+		value, err := replay("a")
+		assert.NoError(t, err)
+		assert.Equal(t, expected, value)
+	}
+
+	_, err = replay("b")
+	assert.EqualError(t, err, "not found")
+
+	// Unrecorded arguments leave the results untouched
+	key, value := "c", 3
+	assert.False(t, recording.Replay("Get", []interface{}{&key}, &value, &err))
+	assert.Equal(t, 3, value)
+}
+
+func TestRecordingLossyTypes(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.json")
+
+	errRecorded := errors.New("recorded")
+	recorder := NewRecorder(path)
+	var value interface{} = struct{ N int }{N: 1}
+	var number interface{} = 1
+	recorder.Record("Get", nil, []interface{}{&value, &number, &errRecorded})
+	require.NoError(t, recorder.Save())
+
+	recording, err := LoadRecording(path)
+	require.NoError(t, err)
+
+	// Interface values are decoded as generic JSON values and errors by message only
+	var replayedValue, replayedNumber interface{}
+	var replayedErr error
+	require.True(t, recording.Replay("Get", nil, &replayedValue, &replayedNumber, &replayedErr))
+	assert.Equal(t, map[string]interface{}{"N": float64(1)}, replayedValue)
+	assert.Equal(t, float64(1), replayedNumber)
+	assert.EqualError(t, replayedErr, "recorded")
+	assert.False(t, errors.Is(replayedErr, errRecorded))
+}
+
+func TestRecordingUnencodableValues(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.json")
+
+	recorder := NewRecorder(path)
+	f := func() {}
+	result := 1
+	recorder.Record("Run", []interface{}{&f}, []interface{}{&result})
+	require.NoError(t, recorder.Save())
+
+	recording, err := LoadRecording(path)
+	require.NoError(t, err)
+
+	// Unencodable arguments match any value
+	g := func() {}
+	var value int
+	assert.True(t, recording.Replay("Run", []interface{}{&g}, &value))
+	assert.Equal(t, 1, value)
+
+	// Unencodable results fail the save
+	ch := make(chan int)
+	recorder.Record("Chan", nil, []interface{}{&ch})
+	assert.Error(t, recorder.Save())
+}
+
+func TestRecordingInterfaceArguments(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "recording.json")
+
+	recorder := NewRecorder(path)
+	record := func(ctx context.Context, key string, value int) {
+		recorder.Record("Get", []interface{}{&ctx, &key}, []interface{}{&value})
+	}
+	record(context.Background(), "a", 1)
+	record(context.Background(), "b", 2)
+	require.NoError(t, recorder.Save())
+
+	recording, err := LoadRecording(path)
+	require.NoError(t, err)
+
+	replay := func(ctx context.Context, key string) (value int, ok bool) {
+		ok = recording.Replay("Get", []interface{}{&ctx, &key}, &value)
+		return value, ok
+	}
+
+	// Interface-typed arguments match any value, while the remaining arguments must match
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	value, ok := replay(ctx, "a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+	value, ok = replay(ctx, "b")
+	assert.True(t, ok)
+	assert.Equal(t, 2, value)
+	_, ok = replay(ctx, "c")
+	assert.False(t, ok)
+}
+
+func TestLoadRecordingMissingFile(t *testing.T) {
+	_, err := LoadRecording(filepath.Join(t.TempDir(), "missing.json"))
+	assert.True(t, errors.Is(err, os.ErrNotExist))
+}
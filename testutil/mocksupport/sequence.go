@@ -0,0 +1,11 @@
+package mocksupport
+
+import "sync/atomic"
+
+var sequence atomic.Uint64
+
+// NextSequence returns a process-wide, monotonically increasing number used to order
+// the invocations of all mock functions relative to one another.
+func NextSequence() uint64 {
+	return sequence.Add(1)
+}
@@ -0,0 +1,39 @@
+package mocksupport
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextSequence(t *testing.T) {
+	first := NextSequence()
+	second := NextSequence()
+	assert.Greater(t, second, first)
+}
+
+func TestNextSequenceConcurrent(t *testing.T) {
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+		seen  = map[uint64]struct{}{}
+	)
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				value := NextSequence()
+				mutex.Lock()
+				seen[value] = struct{}{}
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Len(t, seen, 1000)
+}
@@ -0,0 +1,124 @@
+package mocksupport
+
+import (
+	"context"
+	"sync"
+)
+
+// Subscriptions delivers published values to any number of subscribers. Publishing never
+// blocks: values are queued for each subscriber and delivered in order as the subscriber
+// receives them. The zero value is ready to use.
+type Subscriptions[T any] struct {
+	mutex       sync.Mutex
+	subscribers []*subscriber[T]
+}
+
+type subscriber[T any] struct {
+	mutex   sync.Mutex
+	ch      chan T
+	done    chan struct{}
+	queue   []T
+	pumping bool
+}
+
+// Subscribe returns a channel that receives every value published after this call until
+// the given context is canceled. Once the context is canceled, the subscriber is removed,
+// values that have not yet been received are discarded, and the channel is closed.
+func (s *Subscriptions[T]) Subscribe(ctx context.Context) <-chan T {
+	sub := &subscriber[T]{ch: make(chan T), done: make(chan struct{})}
+
+	s.mutex.Lock()
+	s.subscribers = append(s.subscribers, sub)
+	s.mutex.Unlock()
+
+	context.AfterFunc(ctx, func() { s.unsubscribe(sub) })
+	return sub.ch
+}
+
+// unsubscribe removes the given subscriber and stops delivery of its queued values.
+func (s *Subscriptions[T]) unsubscribe(sub *subscriber[T]) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for i, candidate := range s.subscribers {
+		if candidate == sub {
+			s.subscribers = append(s.subscribers[:i:i], s.subscribers[i+1:]...)
+			break
+		}
+	}
+
+	sub.cancel()
+}
+
+// Publish queues the given value for delivery to all current subscribers.
+func (s *Subscriptions[T]) Publish(v T) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, sub := range s.subscribers {
+		sub.push(v)
+	}
+}
+
+// push adds the value to the subscriber's queue and ensures a goroutine is delivering the
+// queued values. The delivering goroutine exits once the queue has been drained, so idle
+// subscriptions do not hold on to a goroutine.
+func (s *subscriber[T]) push(v T) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.queue = append(s.queue, v)
+	if !s.pumping {
+		s.pumping = true
+		go s.pump()
+	}
+}
+
+func (s *subscriber[T]) pump() {
+	for {
+		s.mutex.Lock()
+		if s.canceled() {
+			// The channel is closed here rather than by cancel, as a send may be in flight
+			s.pumping = false
+			close(s.ch)
+			s.mutex.Unlock()
+			return
+		}
+		if len(s.queue) == 0 {
+			s.pumping = false
+			s.mutex.Unlock()
+			return
+		}
+
+		v := s.queue[0]
+		s.queue = s.queue[1:]
+		s.mutex.Unlock()
+
+		select {
+		case s.ch <- v:
+		case <-s.done:
+		}
+	}
+}
+
+// cancel discards the subscriber's queue and closes its channel, or signals the delivering
+// goroutine to close it if one is running.
+func (s *subscriber[T]) cancel() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.queue = nil
+	close(s.done)
+	if !s.pumping {
+		close(s.ch)
+	}
+}
+
+func (s *subscriber[T]) canceled() bool {
+	select {
+	case <-s.done:
+		return true
+	default:
+		return false
+	}
+}
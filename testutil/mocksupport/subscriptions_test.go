@@ -0,0 +1,68 @@
+package mocksupport
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscriptions(t *testing.T) {
+	var subscriptions Subscriptions[int]
+	subscriptions.Publish(0)
+
+	ch1 := subscriptions.Subscribe(context.Background())
+	subscriptions.Publish(1)
+	ch2 := subscriptions.Subscribe(context.Background())
+	for i := 2; i <= 100; i++ {
+		subscriptions.Publish(i)
+	}
+
+	for i := 1; i <= 100; i++ {
+		assert.Equal(t, i, <-ch1)
+	}
+	for i := 2; i <= 100; i++ {
+		assert.Equal(t, i, <-ch2)
+	}
+}
+
+func TestSubscriptionsCancel(t *testing.T) {
+	var subscriptions Subscriptions[int]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch1 := subscriptions.Subscribe(ctx)
+	ch2 := subscriptions.Subscribe(context.Background())
+
+	// Values queued for a canceled subscriber are discarded, even while one is being delivered
+	subscriptions.Publish(1)
+	subscriptions.Publish(2)
+	cancel()
+
+	for range ch1 {
+	}
+
+	subscriptions.Publish(3)
+	assert.Equal(t, 1, <-ch2)
+	assert.Equal(t, 2, <-ch2)
+	assert.Equal(t, 3, <-ch2)
+
+	subscriptions.mutex.Lock()
+	defer subscriptions.mutex.Unlock()
+	assert.Len(t, subscriptions.subscribers, 1)
+}
+
+func TestSubscriptionsCancelIdle(t *testing.T) {
+	var subscriptions Subscriptions[int]
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := subscriptions.Subscribe(ctx)
+	cancel()
+
+	_, ok := <-ch
+	assert.False(t, ok)
+
+	subscriptions.Publish(1)
+	subscriptions.mutex.Lock()
+	defer subscriptions.mutex.Unlock()
+	assert.Empty(t, subscriptions.subscribers)
+}
@@ -72,3 +72,35 @@ func CalledAtNWith(t require.TestingT, mockFn interface{}, n int, asserter CallI
 		t.FailNow()
 	}
 }
+
+// HooksConsumed asserts that the mock function object has invoked every hook and return
+// value pushed onto its queue.
+func HooksConsumed(t require.TestingT, mockFn interface{}, msgAndArgs ...interface{}) {
+	if !mockassert.HooksConsumed(t, mockFn, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// MaxConcurrentCalls asserts that no more than n invocations of the mock function object
+// were in flight at once.
+func MaxConcurrentCalls(t require.TestingT, mockFn interface{}, n int, msgAndArgs ...interface{}) {
+	if !mockassert.MaxConcurrentCalls(t, mockFn, n, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// MinConcurrentCalls asserts that at least n invocations of the mock function object were
+// in flight at once.
+func MinConcurrentCalls(t require.TestingT, mockFn interface{}, n int, msgAndArgs ...interface{}) {
+	if !mockassert.MinConcurrentCalls(t, mockFn, n, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// InOrder asserts that the given mock function objects were called in the given order. See
+// mockassert.InOrder for the accepted values.
+func InOrder(t require.TestingT, mockFns []interface{}, msgAndArgs ...interface{}) {
+	if !mockassert.InOrder(t, mockFns, msgAndArgs...) {
+		t.FailNow()
+	}
+}